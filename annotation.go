@@ -0,0 +1,355 @@
+package plot
+
+import "math"
+
+// annotationStyle returns the style for drawing an annotation.
+func annotationStyle(plot *Plot, style *Style) *Style {
+	if style.IsZero() {
+		return &plot.Theme.Annotation
+	}
+	return style
+}
+
+// annotationTextStyle returns the style for annotation text with the specified origin.
+func annotationTextStyle(plot *Plot, style *Style, origin Point) *Style {
+	t := *style
+	if t.IsZero() {
+		t = plot.Theme.FontSmall
+	}
+	t.Origin = origin
+	return &t
+}
+
+// HLine implements a horizontal reference line.
+type HLine struct {
+	Style
+	Y     float64
+	Label string
+
+	LabelStyle Style
+}
+
+// NewHLine creates a horizontal reference line at y.
+func NewHLine(y float64, label string) *HLine {
+	return &HLine{Y: y, Label: label}
+}
+
+// Draw draws the element to canvas.
+func (line *HLine) Draw(plot *Plot, canvas Canvas) {
+	if !isFinite(line.Y) {
+		return
+	}
+	canvas = canvas.Clip(canvas.Bounds())
+	size := canvas.Bounds().Size()
+
+	style := *annotationStyle(plot, &line.Style)
+	style.Fill = nil
+
+	if plot.Polar != nil {
+		line.drawPolar(plot, canvas, &style)
+		return
+	}
+
+	p := plot.Y.ToCanvas(line.Y, 0, size.Y)
+	canvas.Poly(Ps(0, p, size.X, p), &style)
+
+	if line.Label != "" {
+		at := Point{size.X - defaultLabelSpacing, p - defaultLabelSpacing*0.5}
+		canvas.Text(line.Label, at, annotationTextStyle(plot, &line.LabelStyle, Point{1, 1}))
+	}
+}
+
+// drawPolar draws the line as a ring.
+func (line *HLine) drawPolar(plot *Plot, canvas Canvas, style *Style) {
+	polar := plot.Polar
+	center, outer := polar.frame(canvas.Bounds().Size())
+	r := polar.radius(plot.Y, line.Y, outer)
+	DrawPath(canvas, polar.arc(center, r), style)
+
+	if line.Label != "" {
+		// the label is outside of the ring at the middle of the X axis
+		angle := polar.Start + polar.sweep()*0.5
+		at := polarPoint(center, angle, r+defaultLabelSpacing*0.5)
+		canvas.Text(line.Label, at, annotationTextStyle(plot, &line.LabelStyle, polarLabelOrigin(angle)))
+	}
+}
+
+// VLine implements a vertical reference line.
+type VLine struct {
+	Style
+	X     float64
+	Label string
+
+	LabelStyle Style
+}
+
+// NewVLine creates a vertical reference line at x.
+func NewVLine(x float64, label string) *VLine {
+	return &VLine{X: x, Label: label}
+}
+
+// Draw draws the element to canvas.
+func (line *VLine) Draw(plot *Plot, canvas Canvas) {
+	if !isFinite(line.X) {
+		return
+	}
+	canvas = canvas.Clip(canvas.Bounds())
+	size := canvas.Bounds().Size()
+
+	style := *annotationStyle(plot, &line.Style)
+	style.Fill = nil
+
+	if plot.Polar != nil {
+		line.drawPolar(plot, canvas, &style)
+		return
+	}
+
+	p := plot.X.ToCanvas(line.X, 0, size.X)
+	canvas.Poly(Ps(p, 0, p, size.Y), &style)
+
+	if line.Label != "" {
+		at := Point{p + defaultLabelSpacing, defaultLabelSpacing}
+		canvas.Text(line.Label, at, annotationTextStyle(plot, &line.LabelStyle, Point{-1, -1}))
+	}
+}
+
+// drawPolar draws the line as a spoke.
+func (line *VLine) drawPolar(plot *Plot, canvas Canvas, style *Style) {
+	polar := plot.Polar
+	center, outer := polar.frame(canvas.Bounds().Size())
+	angle := polar.angle(plot.X, line.X)
+	canvas.Poly([]Point{
+		polarPoint(center, angle, outer*polar.Hole),
+		polarPoint(center, angle, outer),
+	}, style)
+
+	if line.Label != "" {
+		// the label is inside the outer ring, extending towards the center
+		at := polarPoint(center, angle, outer-defaultLabelSpacing)
+		canvas.Text(line.Label, at, annotationTextStyle(plot, &line.LabelStyle, polarLabelOrigin(angle+math.Pi)))
+	}
+}
+
+// XSpan implements a shaded region between two X values.
+type XSpan struct {
+	Style
+	Min, Max float64
+	Label    string
+
+	LabelStyle Style
+}
+
+// NewXSpan creates a shaded region between min and max on the X axis.
+func NewXSpan(min, max float64, label string) *XSpan {
+	return &XSpan{Min: min, Max: max, Label: label}
+}
+
+// Draw draws the element to canvas.
+func (span *XSpan) Draw(plot *Plot, canvas Canvas) {
+	if !isFinite(span.Min) || !isFinite(span.Max) {
+		return
+	}
+	canvas = canvas.Clip(canvas.Bounds())
+	size := canvas.Bounds().Size()
+
+	style := *annotationStyle(plot, &span.Style)
+	style.Stroke = nil
+
+	if plot.Polar != nil {
+		span.drawPolar(plot, canvas, &style)
+		return
+	}
+
+	low, high := plot.X.ToCanvas(span.Min, 0, size.X), plot.X.ToCanvas(span.Max, 0, size.X)
+	if low > high {
+		low, high = high, low
+	}
+	canvas.Rect(R(low, 0, high, size.Y), &style)
+
+	if span.Label != "" {
+		at := Point{(low + high) * 0.5, defaultLabelSpacing}
+		canvas.Text(span.Label, at, annotationTextStyle(plot, &span.LabelStyle, Point{0, -1}))
+	}
+}
+
+// drawPolar draws the span as a wedge.
+func (span *XSpan) drawPolar(plot *Plot, canvas Canvas, style *Style) {
+	polar := plot.Polar
+	center, outer := polar.frame(canvas.Bounds().Size())
+	low, high := polar.angle(plot.X, span.Min), polar.angle(plot.X, span.Max)
+	DrawPath(canvas, wedge(center, low, high-low, outer*polar.Hole, outer), style)
+
+	if span.Label != "" {
+		// the label is inside the outer ring, extending towards the center
+		angle := (low + high) * 0.5
+		at := polarPoint(center, angle, outer-defaultLabelSpacing)
+		canvas.Text(span.Label, at, annotationTextStyle(plot, &span.LabelStyle, polarLabelOrigin(angle+math.Pi)))
+	}
+}
+
+// YSpan implements a shaded region between two Y values.
+type YSpan struct {
+	Style
+	Min, Max float64
+	Label    string
+
+	LabelStyle Style
+}
+
+// NewYSpan creates a shaded region between min and max on the Y axis.
+func NewYSpan(min, max float64, label string) *YSpan {
+	return &YSpan{Min: min, Max: max, Label: label}
+}
+
+// Draw draws the element to canvas.
+func (span *YSpan) Draw(plot *Plot, canvas Canvas) {
+	if !isFinite(span.Min) || !isFinite(span.Max) {
+		return
+	}
+	canvas = canvas.Clip(canvas.Bounds())
+	size := canvas.Bounds().Size()
+
+	style := *annotationStyle(plot, &span.Style)
+	style.Stroke = nil
+
+	if plot.Polar != nil {
+		span.drawPolar(plot, canvas, &style)
+		return
+	}
+
+	low, high := plot.Y.ToCanvas(span.Min, 0, size.Y), plot.Y.ToCanvas(span.Max, 0, size.Y)
+	if low > high {
+		low, high = high, low
+	}
+	canvas.Rect(R(0, low, size.X, high), &style)
+
+	if span.Label != "" {
+		at := Point{defaultLabelSpacing, (low + high) * 0.5}
+		canvas.Text(span.Label, at, annotationTextStyle(plot, &span.LabelStyle, Point{-1, 0}))
+	}
+}
+
+// drawPolar draws the span as a band between two rings.
+func (span *YSpan) drawPolar(plot *Plot, canvas Canvas, style *Style) {
+	polar := plot.Polar
+	center, outer := polar.frame(canvas.Bounds().Size())
+	low, high := polar.radius(plot.Y, span.Min, outer), polar.radius(plot.Y, span.Max, outer)
+	if low > high {
+		low, high = high, low
+	}
+	DrawPath(canvas, polar.sector(center, low, high), style)
+
+	if span.Label != "" {
+		// the label is in the band at the middle of the X axis
+		at := polarPoint(center, polar.Start+polar.sweep()*0.5, (low+high)*0.5)
+		canvas.Text(span.Label, at, annotationTextStyle(plot, &span.LabelStyle, Point{0, 0}))
+	}
+}
+
+// Callout implements a text note with a leader arrow pointing to a data point.
+type Callout struct {
+	Style
+	Target Point
+	// Offset is the position of the text relative to the target in canvas space.
+	Offset    Point
+	Text      string
+	ArrowSize Length
+
+	TextStyle Style
+}
+
+// NewCallout creates a text note pointing to target.
+func NewCallout(target Point, text string) *Callout {
+	return &Callout{
+		Target:    target,
+		Offset:    Point{30, -30},
+		Text:      text,
+		ArrowSize: 6,
+	}
+}
+
+// Draw draws the element to canvas.
+func (callout *Callout) Draw(plot *Plot, canvas Canvas) {
+	if !isFinitePoint(callout.Target) {
+		return
+	}
+	canvas = canvas.Clip(canvas.Bounds())
+	size := canvas.Bounds().Size()
+
+	style := *annotationStyle(plot, &callout.Style)
+	style.Fill = nil
+
+	target := plot.toCanvas(callout.Target, size)
+	at := target.Add(callout.Offset)
+	canvas.Poly([]Point{at, target}, &style)
+
+	if length := math.Hypot(callout.Offset.X, callout.Offset.Y); length > 0 && callout.ArrowSize > 0 {
+		dir := Point{-callout.Offset.X / length, -callout.Offset.Y / length}
+		normal := Point{-dir.Y, dir.X}
+		base := target.Sub(dir.Scale(callout.ArrowSize))
+		half := normal.Scale(callout.ArrowSize * 0.5)
+		canvas.Poly([]Point{target, base.Add(half), base.Sub(half), target}, &Style{
+			Fill:  style.Stroke,
+			Class: style.Class,
+		})
+	}
+
+	if callout.Text != "" {
+		// the text extends away from the target
+		origin := Point{-sign(callout.Offset.X), -sign(callout.Offset.Y)}
+		canvas.Text(callout.Text, at, annotationTextStyle(plot, &callout.TextStyle, origin))
+	}
+}
+
+// Highlight implements a ring around a data point.
+type Highlight struct {
+	Style
+	At     Point
+	Radius Length
+	Label  string
+
+	LabelStyle Style
+}
+
+// NewHighlight creates a ring around the data point at.
+func NewHighlight(at Point, label string) *Highlight {
+	return &Highlight{
+		At:     at,
+		Radius: 6,
+		Label:  label,
+	}
+}
+
+// Draw draws the element to canvas.
+func (highlight *Highlight) Draw(plot *Plot, canvas Canvas) {
+	if !isFinitePoint(highlight.At) {
+		return
+	}
+	canvas = canvas.Clip(canvas.Bounds())
+	size := canvas.Bounds().Size()
+
+	style := *annotationStyle(plot, &highlight.Style)
+	if style.Size == 0 {
+		style.Size = 1
+	}
+
+	center := plot.toCanvas(highlight.At, size)
+	DrawCircle(canvas, center, highlight.Radius, &style)
+
+	if highlight.Label != "" {
+		offset := highlight.Radius*math.Sqrt2*0.5 + defaultLabelSpacing*0.5
+		at := center.Add(Point{offset, -offset})
+		canvas.Text(highlight.Label, at, annotationTextStyle(plot, &highlight.LabelStyle, Point{-1, 1}))
+	}
+}
+
+// sign returns -1, 0 or 1 depending on the sign of v.
+func sign(v float64) float64 {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
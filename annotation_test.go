@@ -0,0 +1,142 @@
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+// textCanvas records the drawn texts in addition to polygons.
+type textCanvas struct {
+	polyCanvas
+	texts []drawnText
+}
+
+type drawnText struct {
+	text  string
+	at    Point
+	style Style
+}
+
+func (canvas *textCanvas) Layer(index int) Canvas { return canvas }
+func (canvas *textCanvas) Clip(r Rect) Canvas     { return canvas }
+func (canvas *textCanvas) Context(r Rect) Canvas  { return canvas }
+
+func (canvas *textCanvas) Text(text string, at Point, style *Style) {
+	canvas.texts = append(canvas.texts, drawnText{text, at, *style})
+}
+
+func newAnnotationTestPlot() *Plot {
+	p := New()
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+	return p
+}
+
+func TestCalloutPlacement(t *testing.T) {
+	tests := []struct {
+		name   string
+		target Point
+		offset Point
+		text   Point
+		origin Point
+	}{
+		{"up right", Point{5, 5}, Point{30, -30}, Point{80, 20}, Point{-1, 1}},
+		{"down left", Point{5, 5}, Point{-20, 10}, Point{30, 60}, Point{1, -1}},
+		{"below", Point{2, 8}, Point{0, 40}, Point{20, 60}, Point{0, -1}},
+		{"on target", Point{5, 5}, Point{0, 0}, Point{50, 50}, Point{0, 0}},
+	}
+
+	for _, test := range tests {
+		p := newAnnotationTestPlot()
+		callout := NewCallout(test.target, "note")
+		callout.Offset = test.offset
+		canvas := &textCanvas{polyCanvas: polyCanvas{bounds: R(0, 0, 100, 100)}}
+		callout.Draw(p, canvas)
+
+		if len(canvas.texts) != 1 {
+			t.Fatalf("%s: got %d texts, expected 1", test.name, len(canvas.texts))
+		}
+		text := canvas.texts[0]
+		if !approxEqualPoint(text.at, test.text) || text.style.Origin != test.origin {
+			t.Errorf("%s: got text at %v with origin %v, expected %v with origin %v", test.name, text.at, text.style.Origin, test.text, test.origin)
+		}
+
+		target := p.toCanvas(test.target, Point{100, 100})
+		if test.offset == (Point{}) {
+			if len(canvas.polys) != 1 {
+				t.Errorf("%s: got %d polygons, expected only the leader", test.name, len(canvas.polys))
+			}
+			continue
+		}
+		if len(canvas.polys) != 2 {
+			t.Fatalf("%s: got %d polygons, expected the leader and the arrow", test.name, len(canvas.polys))
+		}
+		arrow := canvas.polys[1]
+		if !approxEqualPoint(arrow[0], target) {
+			t.Errorf("%s: got arrow tip at %v, expected %v", test.name, arrow[0], target)
+		}
+		// the arrow base is between the target and the text
+		base := arrow[1].Add(arrow[2]).Scale(0.5)
+		d := base.Sub(target)
+		if math.Abs(math.Hypot(d.X, d.Y)-callout.ArrowSize) > 1e-9 || d.X*test.offset.X+d.Y*test.offset.Y <= 0 {
+			t.Errorf("%s: got arrow base at %v, expected it towards %v", test.name, base, test.offset)
+		}
+	}
+
+	// missing target
+	canvas := &textCanvas{polyCanvas: polyCanvas{bounds: R(0, 0, 100, 100)}}
+	NewCallout(Point{math.NaN(), 5}, "note").Draw(newAnnotationTestPlot(), canvas)
+	if len(canvas.texts) != 0 || len(canvas.polys) != 0 {
+		t.Errorf("missing target: drew %d texts and %d polygons", len(canvas.texts), len(canvas.polys))
+	}
+}
+
+func TestHighlightPlacement(t *testing.T) {
+	tests := []struct {
+		name   string
+		at     Point
+		radius Length
+		label  string
+	}{
+		{"default", Point{5, 5}, 6, "peak"},
+		{"large", Point{2, 8}, 20, "peak"},
+		{"without label", Point{5, 5}, 6, ""},
+	}
+
+	for _, test := range tests {
+		p := newAnnotationTestPlot()
+		highlight := NewHighlight(test.at, test.label)
+		highlight.Radius = test.radius
+		canvas := &textCanvas{polyCanvas: polyCanvas{bounds: R(0, 0, 100, 100)}}
+		highlight.Draw(p, canvas)
+
+		center := p.toCanvas(test.at, Point{100, 100})
+		if len(canvas.polys) != 1 {
+			t.Fatalf("%s: got %d polygons, expected the ring", test.name, len(canvas.polys))
+		}
+		for _, q := range canvas.polys[0] {
+			d := q.Sub(center)
+			if math.Abs(math.Hypot(d.X, d.Y)-test.radius) > test.radius*0.01 {
+				t.Errorf("%s: ring point %v isn't at radius %v from %v", test.name, q, test.radius, center)
+				break
+			}
+		}
+
+		if test.label == "" {
+			if len(canvas.texts) != 0 {
+				t.Errorf("%s: got %d texts, expected none", test.name, len(canvas.texts))
+			}
+			continue
+		}
+		if len(canvas.texts) != 1 {
+			t.Fatalf("%s: got %d texts, expected 1", test.name, len(canvas.texts))
+		}
+		// the label is at the top right of the ring, just outside of it
+		offset := test.radius*math.Sqrt2*0.5 + defaultLabelSpacing*0.5
+		expected := center.Add(Point{offset, -offset})
+		text := canvas.texts[0]
+		if !approxEqualPoint(text.at, expected) || text.style.Origin != (Point{-1, 1}) {
+			t.Errorf("%s: got label at %v with origin %v, expected %v", test.name, text.at, text.style.Origin, expected)
+		}
+	}
+}
@@ -0,0 +1,379 @@
+package plot
+
+import (
+	"math"
+	"sort"
+)
+
+// Area implements a filled area between a series and a baseline.
+type Area struct {
+	Style
+	Label string
+
+	Data []Point
+
+	// Baseline is the constant value to fill to, when BaselineData is nil.
+	Baseline float64
+	// BaselineData is the series to fill to.
+	// When Data has missing values, it's cut to each filled part.
+	BaselineData []Point
+
+	sorted sortedCache
+}
+
+// NewArea creates an area filled between points and zero.
+func NewArea(label string, points []Point) *Area {
+	return &Area{
+		Label: label,
+		Data:  points,
+	}
+}
+
+// NewAreaBetween creates an area filled between points and baseline.
+func NewAreaBetween(label string, points, baseline []Point) *Area {
+	return &Area{
+		Label:        label,
+		Data:         points,
+		BaselineData: baseline,
+	}
+}
+
+// Stats calculates element statistics.
+func (area *Area) Stats() Stats {
+	stats := PointsStats(area.Data)
+	if area.BaselineData != nil {
+		return maximalStats([]Element{
+			&Line{Data: area.Data},
+			&Line{Data: area.BaselineData},
+		})
+	}
+	stats.Min.Y = math.Min(stats.Min.Y, area.Baseline)
+	stats.Max.Y = math.Max(stats.Max.Y, area.Baseline)
+	return stats
+}
+
+// baseline returns the data points for the lower edge of data,
+// which is one of the parts of Data split at missing values.
+func (area *Area) baseline(plot *Plot, data []Point, split bool) []Point {
+	if area.BaselineData != nil {
+		baseline := finitePoints(area.BaselineData)
+		if split && len(data) > 0 {
+			baseline = pointsBetween(baseline, data[0].X, data[len(data)-1].X)
+		}
+		return baseline
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if plot.Polar != nil {
+		// a straight baseline would cut across the circle
+		points := make([]Point, 0, len(data))
+		for _, p := range data {
+			points = append(points, Point{p.X, area.Baseline})
+		}
+		return points
+	}
+	return []Point{
+		{data[0].X, area.Baseline},
+		{data[len(data)-1].X, area.Baseline},
+	}
+}
+
+// Draw draws the element to canvas.
+func (area *Area) Draw(plot *Plot, canvas Canvas) {
+	canvas = canvas.Clip(canvas.Bounds())
+	bounds := canvas.Bounds()
+
+	style := &area.Style
+	if style.IsZero() {
+		style = plot.autoStyle(area, &plot.Theme.Area)
+	}
+	style = plot.resolveStyle(style, bounds)
+
+	parts := splitGaps(area.Data)
+	for _, data := range parts {
+		upper := plot.project(data, bounds)
+		lower := plot.project(area.baseline(plot, data, len(parts) > 1), bounds)
+		drawArea(canvas, upper, lower, style)
+	}
+}
+
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (area *Area) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	return hitTestPoints(area, area.Label, area.Data, &area.sorted, plot, bounds, at)
+}
+
+// StackBaseline determines the baseline of a stacked area chart.
+type StackBaseline byte
+
+const (
+	// StackZero stacks the series on top of zero.
+	StackZero StackBaseline = iota
+	// StackSymmetric stacks the series symmetrically around zero.
+	StackSymmetric
+	// StackWiggle minimizes the slopes of the layers, as in streamgraphs.
+	StackWiggle
+)
+
+// StackSeries is a single series in a stacked area chart.
+type StackSeries struct {
+	Style
+	Label  string
+	Values []float64
+}
+
+// StackedArea implements stacked area chart of series sharing X values.
+type StackedArea struct {
+	X      []float64
+	Series []*StackSeries
+
+	Baseline StackBaseline
+	// Normalized scales the series such that they sum to 1 at each X.
+	Normalized bool
+}
+
+// NewStackedArea creates a stacked area chart using x as the shared X values.
+func NewStackedArea(x []float64) *StackedArea {
+	return &StackedArea{X: x}
+}
+
+// Add adds a new series on top of the stack.
+func (stack *StackedArea) Add(label string, values []float64) *StackSeries {
+	series := &StackSeries{Label: label, Values: values}
+	stack.Series = append(stack.Series, series)
+	return series
+}
+
+// value returns the value of series at index i, treating missing values as zero.
+func (series *StackSeries) value(i int) float64 {
+	if i >= len(series.Values) || !isFinite(series.Values[i]) {
+		return 0
+	}
+	return series.Values[i]
+}
+
+// layers calculates the boundaries between the layers,
+// layers[k] is the lower edge of k-th series and layers[len(Series)]
+// is the upper edge of the last series.
+func (stack *StackedArea) layers() [][]float64 {
+	n := len(stack.Series)
+	layers := make([][]float64, n+1)
+	for k := range layers {
+		layers[k] = make([]float64, len(stack.X))
+	}
+
+	for i := range stack.X {
+		scale := 1.0
+		if stack.Normalized {
+			total := 0.0
+			for _, series := range stack.Series {
+				total += series.value(i)
+			}
+			if total != 0 {
+				scale = 1 / total
+			}
+		}
+
+		base := 0.0
+		switch stack.Baseline {
+		case StackSymmetric:
+			for _, series := range stack.Series {
+				base -= series.value(i) * scale * 0.5
+			}
+		case StackWiggle:
+			for k, series := range stack.Series {
+				base -= float64(n-k) * series.value(i) * scale
+			}
+			base /= float64(n + 1)
+		}
+
+		layers[0][i] = base
+		for k, series := range stack.Series {
+			layers[k+1][i] = layers[k][i] + series.value(i)*scale
+		}
+	}
+
+	return layers
+}
+
+// Stats calculates element statistics.
+func (stack *StackedArea) Stats() Stats {
+	if len(stack.X) == 0 || len(stack.Series) == 0 {
+		return nanStats
+	}
+
+	layers := stack.layers()
+	lowest, highest := layers[0], layers[len(layers)-1]
+
+	stats := nanStats
+	stats.Min.X, stats.Max.X = stack.X[0], stack.X[0]
+	stats.Min.Y, stats.Max.Y = lowest[0], highest[0]
+	for i, x := range stack.X {
+		stats.Min.X = math.Min(stats.Min.X, x)
+		stats.Max.X = math.Max(stats.Max.X, x)
+		stats.Min.Y = math.Min(stats.Min.Y, lowest[i])
+		stats.Max.Y = math.Max(stats.Max.Y, highest[i])
+	}
+	stats.Center = stats.Min.Add(stats.Max).Scale(0.5)
+	return stats
+}
+
+// Draw draws the element to canvas.
+func (stack *StackedArea) Draw(plot *Plot, canvas Canvas) {
+	canvas = canvas.Clip(canvas.Bounds())
+	bounds := canvas.Bounds()
+
+	layers := stack.layers()
+	for k, series := range stack.Series {
+		lower := plot.project(Points(stack.X, layers[k]), bounds)
+		upper := plot.project(Points(stack.X, layers[k+1]), bounds)
+
+		style := &series.Style
+		if style.IsZero() {
+			style = plot.autoStyle(series, &plot.Theme.Area)
+		}
+		drawArea(canvas, upper, lower, plot.resolveStyle(style, bounds))
+	}
+}
+
+// HitTest finds the series under at, when the element is drawn to bounds.
+func (stack *StackedArea) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	if len(stack.X) == 0 || len(stack.Series) == 0 || math.IsNaN(at.X) {
+		return Hit{}, false
+	}
+
+	size := bounds.Size()
+	local := at.Sub(bounds.Min)
+
+	// find the nearest X value
+	var x float64
+	if plot.Polar != nil {
+		if math.IsNaN(local.Y) {
+			return Hit{}, false
+		}
+		x = plot.Polar.FromCanvas(plot.X, plot.Y, local, size).X
+	} else {
+		x = plot.X.FromCanvas(local.X, 0, size.X)
+	}
+	index := sort.SearchFloat64s(stack.X, x)
+	if index >= len(stack.X) || (index > 0 && x-stack.X[index-1] < stack.X[index]-x) {
+		index--
+	}
+
+	layers := stack.layers()
+	best := Hit{Index: -1, Distance: math.Inf(1)}
+	for k, series := range stack.Series {
+		low := Point{stack.X[index], layers[k][index]}
+		high := Point{stack.X[index], layers[k+1][index]}
+		position, distance := bandDistance(plot, size, local, low, high)
+
+		if distance < best.Distance {
+			best = Hit{
+				Element:  stack,
+				Label:    series.Label,
+				Index:    index,
+				Value:    Point{stack.X[index], series.value(index)},
+				Position: position.Add(bounds.Min),
+				Distance: distance,
+			}
+		}
+	}
+
+	return best, best.Index >= 0
+}
+
+// bandDistance calculates the distance from local to the band between
+// the data points low and high, which have the same X value.
+func bandDistance(plot *Plot, size, local, low, high Point) (position Point, distance Length) {
+	a, b := plot.toCanvas(low, size), plot.toCanvas(high, size)
+	position = a.Add(b).Scale(0.5)
+	if plot.Polar != nil {
+		return position, segmentDistance(local, a, b)
+	}
+
+	bottom, top := math.Min(a.Y, b.Y), math.Max(a.Y, b.Y)
+	switch {
+	case math.IsNaN(local.Y):
+		distance = 0
+	case local.Y < bottom:
+		distance = bottom - local.Y
+	case local.Y > top:
+		distance = local.Y - top
+	}
+	return position, distance
+}
+
+// drawArea fills the area between upper and lower canvas space points,
+// and draws the stroke along the upper edge.
+func drawArea(canvas Canvas, upper, lower []Point, style *Style) {
+	if len(upper) == 0 {
+		return
+	}
+
+	if style.HasFill() {
+		polygon := make([]Point, 0, len(upper)+len(lower)+1)
+		polygon = append(polygon, upper...)
+		for i := len(lower) - 1; i >= 0; i-- {
+			polygon = append(polygon, lower[i])
+		}
+		polygon = append(polygon, upper[0])
+
+		fill := *style
+		fill.Stroke = nil
+		canvas.Poly(polygon, &fill)
+	}
+
+	if style.Stroke != nil {
+		stroke := *style
+		stroke.Fill = nil
+		stroke.FillGradient = nil
+		canvas.Poly(upper, &stroke)
+	}
+}
+
+// finitePoints returns points without non-finite values.
+func finitePoints(points []Point) []Point {
+	for _, p := range points {
+		if !isFinitePoint(p) {
+			goto filter
+		}
+	}
+	return points
+
+filter:
+	finite := make([]Point, 0, len(points))
+	for _, p := range points {
+		if isFinitePoint(p) {
+			finite = append(finite, p)
+		}
+	}
+	return finite
+}
+
+// pointsBetween returns the points from x0 to x1 with interpolated ends,
+// points must be sorted by X.
+func pointsBetween(points []Point, x0, x1 float64) []Point {
+	if len(points) == 0 {
+		return nil
+	}
+
+	at := func(x float64) Point {
+		i := sort.Search(len(points), func(i int) bool { return points[i].X >= x })
+		switch i {
+		case 0:
+			return Point{x, points[0].Y}
+		case len(points):
+			return Point{x, points[len(points)-1].Y}
+		}
+		a, b := points[i-1], points[i]
+		return Point{x, lerp(invlerp(x, a.X, b.X), a.Y, b.Y)}
+	}
+
+	result := []Point{at(x0)}
+	for _, p := range points {
+		if x0 < p.X && p.X < x1 {
+			result = append(result, p)
+		}
+	}
+	return append(result, at(x1))
+}
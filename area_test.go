@@ -0,0 +1,51 @@
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStackedAreaLayers(t *testing.T) {
+	tests := []struct {
+		name       string
+		baseline   StackBaseline
+		normalized bool
+		expected   [][]float64
+	}{
+		{"zero", StackZero, false, [][]float64{{0, 0}, {1, 2}, {4, 2}}},
+		{"symmetric", StackSymmetric, false, [][]float64{{-2, -1}, {-1, 1}, {2, 1}}},
+		{"wiggle", StackWiggle, false, [][]float64{{-5.0 / 3, -4.0 / 3}, {-2.0 / 3, 2.0 / 3}, {7.0 / 3, 2.0 / 3}}},
+		{"normalized", StackZero, true, [][]float64{{0, 0}, {0.25, 1}, {1, 1}}},
+		{"normalized symmetric", StackSymmetric, true, [][]float64{{-0.5, -0.5}, {-0.25, 0.5}, {0.5, 0.5}}},
+	}
+
+	for _, test := range tests {
+		stack := NewStackedArea([]float64{0, 1})
+		stack.Add("a", []float64{1, 2})
+		stack.Add("b", []float64{3, math.NaN()})
+		stack.Baseline = test.baseline
+		stack.Normalized = test.normalized
+
+		layers := stack.layers()
+		if len(layers) != len(test.expected) {
+			t.Fatalf("%s: got %d layers, expected %d", test.name, len(layers), len(test.expected))
+		}
+		for k, layer := range layers {
+			for i, v := range layer {
+				if math.Abs(v-test.expected[k][i]) > 1e-9 {
+					t.Errorf("%s: got layers %v, expected %v", test.name, layers, test.expected)
+					break
+				}
+			}
+		}
+
+		// the layers are as thick as the series
+		scale := 1.0
+		if test.normalized {
+			scale = 0.25
+		}
+		if thickness := layers[2][0] - layers[1][0]; math.Abs(thickness-3*scale) > 1e-9 {
+			t.Errorf("%s: got thickness %v, expected %v", test.name, thickness, 3*scale)
+		}
+	}
+}
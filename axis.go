@@ -104,15 +104,19 @@ func (axis *Axis) FromCanvas(s Length, screenMin, screenMax Length) float64 {
 	return low + n*(high-low)
 }
 
-// Include ensures that min and max can be displayed on the axis.
+// Include ensures that min and max can be displayed on the axis, NaN values are ignored.
 func (axis *Axis) Include(min, max float64) {
-	if math.IsNaN(axis.Min) {
+	if math.IsNaN(min) {
+		// ignore
+	} else if math.IsNaN(axis.Min) {
 		axis.Min = min
 	} else {
 		axis.Min = math.Min(axis.Min, min)
 	}
 
-	if math.IsNaN(axis.Max) {
+	if math.IsNaN(max) {
+		// ignore
+	} else if math.IsNaN(axis.Max) {
 		axis.Max = max
 	} else {
 		axis.Max = math.Max(axis.Max, max)
@@ -158,6 +162,27 @@ func detectAxis(x, y *Axis, elements []Element) (X, Y *Axis) {
 	return tx, ty
 }
 
+// alignAxis adjusts the range of secondary such that its major ticks
+// are at the same positions as the major ticks of primary.
+func alignAxis(secondary, primary *Axis) {
+	intervals := primary.MajorTicks
+	if intervals <= 0 || !secondary.IsValid() || secondary.Max <= secondary.Min {
+		return
+	}
+	secondary.MajorTicks = primary.MajorTicks
+	secondary.MinorTicks = primary.MinorTicks
+
+	step := niceNumber((secondary.Max-secondary.Min)/float64(intervals), false)
+	min := math.Floor(secondary.Min/step) * step
+	for min+step*float64(intervals) < secondary.Max {
+		step = niceNumber(step*1.0001, false)
+		min = math.Floor(secondary.Min/step) * step
+	}
+
+	secondary.Min = min
+	secondary.Max = min + step*float64(intervals)
+}
+
 // niceAxis calculates nice range for a given min, max or values.
 func niceAxis(min, max float64, major, minor int) (nicemin, nicemax float64) {
 	span := niceNumber(max-min, false)
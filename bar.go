@@ -78,7 +78,7 @@ func (bar *Bar) Draw(plot *Plot, canvas Canvas) {
 
 	style := &bar.Style
 	if style.IsZero() {
-		style = &plot.Theme.Bar
+		style = plot.autoStyle(bar, &plot.Theme.Bar)
 	}
 
 	lastScreenMin := 0.0
@@ -113,3 +113,48 @@ func (bar *Bar) Draw(plot *Plot, canvas Canvas) {
 		canvas.Rect(r, style)
 	})
 }
+
+// HitTest finds the bar under at, when the element is drawn to bounds.
+func (bar *Bar) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	x, y := plot.X, plot.Y
+	size := bounds.Size()
+	local := at.Sub(bounds.Min)
+	value := x.FromCanvas(local.X, 0, size.X)
+
+	hit := Hit{
+		Element: bar,
+		Label:   bar.Label,
+		Index:   -1,
+	}
+
+	index := 0
+	bar.iter(func(p Point, left, right float64) {
+		defer func() { index++ }()
+		if hit.Index >= 0 || value < left || right <= value {
+			return
+		}
+
+		bottom, top := y.ToCanvas(0, 0, size.Y), y.ToCanvas(p.Y, 0, size.Y)
+		if bottom > top {
+			bottom, top = top, bottom
+		}
+
+		hit.Index = index
+		hit.Value = p
+		hit.Position = Point{
+			X: x.ToCanvas((left+right)*0.5, 0, size.X),
+			Y: y.ToCanvas(p.Y, 0, size.Y),
+		}.Add(bounds.Min)
+
+		switch {
+		case math.IsNaN(local.Y):
+			hit.Distance = 0
+		case local.Y < bottom:
+			hit.Distance = bottom - local.Y
+		case local.Y > top:
+			hit.Distance = local.Y - top
+		}
+	})
+
+	return hit, hit.Index >= 0
+}
@@ -1,5 +1,7 @@
 package plot
 
+import "image"
+
 // Canvas describes interface for drawing graphics.
 type Canvas interface {
 	Bounds() Rect
@@ -10,3 +12,108 @@ type Canvas interface {
 	Poly(points []Point, style *Style)
 	Rect(r Rect, style *Style)
 }
+
+// ThemedCanvas is a Canvas that applies global styling from the theme,
+// such as text halos.
+type ThemedCanvas interface {
+	Canvas
+	SetTheme(theme *Theme)
+}
+
+// DescribedCanvas is a Canvas that records the plot title and description,
+// e.g. for accessibility.
+type DescribedCanvas interface {
+	Canvas
+	SetDescription(title, description string)
+}
+
+// OpacityCanvas is a Canvas that composites content with a group opacity.
+type OpacityCanvas interface {
+	Canvas
+	// Opacity returns a context whose content is composited with alpha as a group.
+	Opacity(alpha float64) Canvas
+}
+
+// WithOpacity returns a context of canvas whose content is composited with alpha,
+// when canvas isn't an OpacityCanvas the content is drawn opaque.
+func WithOpacity(canvas Canvas, alpha float64) Canvas {
+	if group, ok := canvas.(OpacityCanvas); ok {
+		return group.Opacity(alpha)
+	}
+	return canvas
+}
+
+// PathCanvas is a Canvas that draws paths and ellipses natively,
+// otherwise they are approximated with polygons.
+type PathCanvas interface {
+	Canvas
+	Path(path *Path, style *Style)
+	Circle(center Point, radius Length, style *Style)
+	Ellipse(center, radius Point, style *Style)
+}
+
+// DrawPath draws path to canvas, using polygons when canvas isn't a PathCanvas.
+func DrawPath(canvas Canvas, path *Path, style *Style) {
+	if paths, ok := canvas.(PathCanvas); ok {
+		paths.Path(path, style)
+		return
+	}
+	for _, line := range path.Flatten(0) {
+		canvas.Poly(line, style)
+	}
+}
+
+// DrawCircle draws a circle to canvas, using a polygon when canvas isn't a PathCanvas.
+func DrawCircle(canvas Canvas, center Point, radius Length, style *Style) {
+	if paths, ok := canvas.(PathCanvas); ok {
+		paths.Circle(center, radius, style)
+		return
+	}
+	DrawPath(canvas, NewEllipsePath(center, Point{radius, radius}), style)
+}
+
+// DrawEllipse draws an ellipse to canvas, using a polygon when canvas isn't a PathCanvas.
+func DrawEllipse(canvas Canvas, center, radius Point, style *Style) {
+	if paths, ok := canvas.(PathCanvas); ok {
+		paths.Ellipse(center, radius, style)
+		return
+	}
+	DrawPath(canvas, NewEllipsePath(center, radius), style)
+}
+
+// ImageCanvas is a Canvas that draws raster images.
+type ImageCanvas interface {
+	Canvas
+	// Image draws img scaled to dst, using style.Sampling.
+	Image(img image.Image, dst Rect, style *Style)
+}
+
+// DrawImage draws img scaled to dst, when canvas isn't an ImageCanvas
+// each pixel is drawn as a rectangle.
+func DrawImage(canvas Canvas, img image.Image, dst Rect, style *Style) {
+	if images, ok := canvas.(ImageCanvas); ok {
+		images.Image(img, dst, style)
+		return
+	}
+
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return
+	}
+	size := dst.Size()
+	pixel := Point{size.X / float64(bounds.Dx()), size.Y / float64(bounds.Dy())}
+
+	cell := *style
+	cell.Stroke = nil
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			col := img.At(x, y)
+			if _, _, _, a := col.RGBA(); a == 0 {
+				continue
+			}
+			min := dst.Min.Add(Point{float64(x-bounds.Min.X) * pixel.X, float64(y-bounds.Min.Y) * pixel.Y})
+			cell.Fill = col
+			canvas.Rect(Rect{min, min.Add(pixel)}, &cell)
+		}
+	}
+}
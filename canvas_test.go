@@ -0,0 +1,70 @@
+package plot
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+)
+
+// polyCanvas is a Canvas without optional capabilities, which records polygons.
+type polyCanvas struct {
+	bounds Rect
+	polys  [][]Point
+	rects  []Rect
+}
+
+func (canvas *polyCanvas) Bounds() Rect           { return canvas.bounds }
+func (canvas *polyCanvas) Layer(index int) Canvas { return canvas }
+func (canvas *polyCanvas) Clip(r Rect) Canvas     { return canvas }
+func (canvas *polyCanvas) Context(r Rect) Canvas  { return canvas }
+
+func (canvas *polyCanvas) Text(text string, at Point, style *Style) {}
+func (canvas *polyCanvas) Poly(points []Point, style *Style) {
+	canvas.polys = append(canvas.polys, points)
+}
+func (canvas *polyCanvas) Rect(r Rect, style *Style) {
+	canvas.rects = append(canvas.rects, r)
+}
+
+func TestDrawCircleFallback(t *testing.T) {
+	canvas := &polyCanvas{}
+	center, radius := Point{10, 20}, Length(5)
+	DrawCircle(canvas, center, radius, &Style{})
+
+	if len(canvas.polys) != 1 {
+		t.Fatalf("got %d polygons", len(canvas.polys))
+	}
+	poly := canvas.polys[0]
+	if len(poly) < 8 {
+		t.Errorf("got %d points", len(poly))
+	}
+	if poly[0] != poly[len(poly)-1] {
+		t.Errorf("polygon is not closed")
+	}
+	for _, p := range poly {
+		d := p.Sub(center)
+		if r := math.Hypot(d.X, d.Y); math.Abs(r-radius) > 0.1 {
+			t.Errorf("point %v is %v from center", p, r)
+		}
+	}
+}
+
+func TestDrawImageFallback(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.SetNRGBA(0, 0, color.NRGBA{255, 0, 0, 255})
+	img.SetNRGBA(1, 1, color.NRGBA{0, 0, 255, 255})
+
+	canvas := &polyCanvas{}
+	DrawImage(canvas, img, R(10, 10, 30, 50), &Style{})
+
+	expected := []Rect{R(10, 10, 20, 30), R(20, 30, 30, 50)}
+	if len(canvas.rects) != len(expected) {
+		t.Fatalf("got %v, expected %v", canvas.rects, expected)
+	}
+	for i := range expected {
+		if canvas.rects[i] != expected[i] {
+			t.Errorf("pixel %d: got %v, expected %v", i, canvas.rects[i], expected[i])
+		}
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"image"
 	"image/color"
 	"io/ioutil"
 	"math"
@@ -54,5 +55,32 @@ func main() {
 		Dash:   []plot.Length{1, 2, 3},
 	})
 
+	curve := &plot.Path{}
+	curve.MoveTo(plot.P(0, size.Y))
+	curve.CubicTo(plot.P(size.X/3, 0), plot.P(size.X*2/3, 0), plot.P(size.X, size.Y))
+	plot.DrawPath(graphic, curve, &plot.Style{
+		Stroke: color.NRGBA{0, 0, 200, 255},
+		Size:   2,
+	})
+
+	marker := &plot.Style{
+		Stroke: color.NRGBA{0, 0, 200, 255},
+		Fill:   color.NRGBA{0, 0, 200, 40},
+		Size:   1,
+	}
+	plot.DrawCircle(graphic, plot.P(size.X/2, size.Y*3/4), 10, marker)
+	plot.DrawEllipse(graphic, plot.P(size.X/2, size.Y*3/4), plot.P(40, 20), marker)
+
+	checker := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			if (x+y)%2 == 0 {
+				checker.SetNRGBA(x, y, color.NRGBA{0, 0, 200, 255})
+			}
+		}
+	}
+	plot.DrawImage(graphic, checker, plot.R(20, 20, 100, 100), &plot.Style{Sampling: plot.SampleNearest})
+	plot.DrawImage(graphic, checker, plot.R(120, 20, 200, 100), &plot.Style{Sampling: plot.SampleLinear})
+
 	ioutil.WriteFile("example.svg", canvas.Bytes(), 0755)
 }
@@ -91,17 +91,11 @@ func main() {
 		return ordered[k].Time.Before(ordered[i].Time)
 	})
 
-	defaultMargin := plot.R(5, 5, 5, 5)
-
 	p := plot.New()
-	stack := plot.NewVStack()
-	stack.Margin = defaultMargin
-	p.Add(stack)
+	p.Padding = plot.R(5, 5, 5, 5)
 
 	p.X.Min = 0
-	p.Y.Max = 1
-	p.Y.Min = -0.3
-
+	p.X.Max = maxLifetime.Hours()
 	p.X.Transform = plot.NewLog1pTransform(2)
 	p.X.Ticks = plot.ManualTicks{
 		{Value: 0, Label: "0"},
@@ -118,53 +112,27 @@ func main() {
 		{Value: 6 * 30 * 24, Label: "6m"},
 	}
 
+	ridge := plot.NewRidgeline()
+	ridge.Kernel = 0.05
+	ridge.Overlap = 1.5
 	for _, g := range ordered {
-		flex := plot.NewHFlex()
-
-		text := plot.NewTextbox(g.Time.Format("2006-01"))
-		text.Style.Origin = plot.Point{X: -1, Y: 0}
-		flex.Add(80, text)
-
 		lifetimes := g.Lifetimes()
 		for i, v := range lifetimes {
 			if v > maxLifetime {
 				lifetimes[i] = maxLifetime
 			}
 		}
-
-		labels := plot.NewTickLabelsX()
-		labels.Style.Origin = plot.Point{X: 0, Y: -1}
-
-		density := plot.NewDensity("", plot.DurationTo(lifetimes, time.Hour))
-		density.Kernel = 0.05
-
-		line := plot.NewLine("", plot.Ps(
-			0, 0.1,
-			1, 0.2,
-			2, 0.3,
-			6, 0.4,
-			12, 0.5,
-			1*24, 0.6,
-			2*24, 0.7,
-			7*24, 0.8,
-			2*7*24, 0.9,
-			1*30*24, 1.0,
-			2*30*24, 1.1,
-			6*30*24, 1.2,
-		))
-		_ = line
-
-		flex.AddGroup(0,
-			// plot.NewGrid(),
-			plot.NewGizmo(),
-			density,
-			// line,
-			labels,
-		)
-		stack.Add(flex)
+		ridge.Add(g.Time.Format("2006-01"), plot.DurationTo(lifetimes, time.Hour))
 	}
 
-	svg := plotsvg.New(400, float64(50*len(ordered)))
+	p.AddGroup(
+		plot.NewGrid(),
+		ridge,
+		plot.NewTickLabelsX(),
+	)
+
+	svg := plotsvg.New(400, float64(20*len(ordered)+60))
+	p.Layout(svg)
 	p.Draw(svg)
 
 	os.Stdout.Write(svg.Bytes())
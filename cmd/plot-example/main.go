@@ -12,7 +12,7 @@ import (
 )
 
 func main() {
-	defaultMargin := plot.R(20, 20, 20, 20)
+	defaultPadding := plot.R(5, 5, 5, 5)
 
 	type Dataset struct {
 		Red   []float64
@@ -48,7 +48,7 @@ func main() {
 	{ // density plot
 		p := plot.New()
 		stack := plot.NewVStack()
-		stack.Margin = defaultMargin
+		stack.Padding = defaultPadding
 		p.Add(stack)
 		for i, dataset := range datasets {
 			red := plot.NewDensity("Red", dataset.Red)
@@ -64,7 +64,10 @@ func main() {
 			blue := plot.NewDensity("Blue", dataset.Blue)
 			blue.Class = "blue"
 			blue.Stroke = color.NRGBA{0, 0, 200, 255}
-			blue.Fill = color.NRGBA{0, 0, 200, 40}
+			blue.FillGradient = plot.NewLinearGradient(plot.P(0, 0), plot.P(0, 1),
+				plot.GradientStop{Offset: 0, Color: color.NRGBA{0, 0, 200, 120}},
+				plot.GradientStop{Offset: 1, Color: color.NRGBA{0, 0, 200, 0}},
+			)
 
 			stack.AddGroup(
 				plot.NewGrid(),
@@ -76,6 +79,7 @@ func main() {
 		}
 
 		svg := plotsvg.New(800, float64(200*len(datasets)))
+		p.Layout(svg)
 		p.Draw(svg)
 		ioutil.WriteFile("density.svg", svg.Bytes(), 0755)
 	}
@@ -83,7 +87,7 @@ func main() {
 	{ // violin plot
 		p := plot.New()
 		stack := plot.NewHStack()
-		stack.Margin = defaultMargin
+		stack.Padding = defaultPadding
 		p.Add(stack)
 		for i, dataset := range datasets {
 			red := plot.NewViolin("Red", dataset.Red)
@@ -107,13 +111,14 @@ func main() {
 			stack.AddGroup(
 				plot.NewGrid(),
 				plot.NewGizmo(),
-				red, green, blue,
+				plot.NewOpacityGroup(0.6, red, green, blue),
 				plot.NewTickLabels(),
 				plot.NewXLabel("Case "+strconv.Itoa(i+1)),
 			)
 		}
 
 		svg := plotsvg.New(800, float64(200*len(datasets)))
+		p.Layout(svg)
 		p.Draw(svg)
 		ioutil.WriteFile("violin.svg", svg.Bytes(), 0755)
 	}
@@ -123,7 +128,7 @@ func main() {
 		p.X = plot.NewPercentilesAxis()
 
 		stack := plot.NewVStack()
-		stack.Margin = defaultMargin
+		stack.Padding = defaultPadding
 
 		p.Add(stack)
 		for i, dataset := range datasets {
@@ -139,6 +144,47 @@ func main() {
 			blue.Class = "blue"
 			blue.Stroke = color.NRGBA{0, 0, 200, 255}
 
+			labels := plot.NewTickLabels()
+			labels.X.Placement = plot.LabelsDrop
+
+			stack.AddGroup(
+				plot.NewGrid(),
+				plot.NewGizmo(),
+				plot.NewTooltips(red, green, blue),
+				labels,
+				plot.NewXLabel("Case "+strconv.Itoa(i+1)),
+			)
+		}
+
+		svg := plotsvg.New(800, float64(200*len(datasets)))
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("percentiles.svg", svg.Bytes(), 0755)
+	}
+
+	{ // ecdf plot
+		p := plot.New()
+
+		stack := plot.NewVStack()
+		stack.Padding = defaultPadding
+
+		p.Add(stack)
+		for i, dataset := range datasets {
+			red := plot.NewECDF("Red", dataset.Red)
+			red.Class = "red"
+			red.Stroke = color.NRGBA{200, 0, 0, 255}
+			red.Confidence = 0.95
+
+			green := plot.NewECDF("Green", dataset.Green)
+			green.Class = "green"
+			green.Stroke = color.NRGBA{0, 200, 0, 255}
+			green.Confidence = 0.95
+
+			blue := plot.NewECDF("Blue", dataset.Blue)
+			blue.Class = "blue"
+			blue.Stroke = color.NRGBA{0, 0, 200, 255}
+			blue.Confidence = 0.95
+
 			stack.AddGroup(
 				plot.NewGrid(),
 				plot.NewGizmo(),
@@ -149,14 +195,284 @@ func main() {
 		}
 
 		svg := plotsvg.New(800, float64(200*len(datasets)))
+		p.Layout(svg)
 		p.Draw(svg)
-		ioutil.WriteFile("percentiles.svg", svg.Bytes(), 0755)
+		ioutil.WriteFile("ecdf.svg", svg.Bytes(), 0755)
+	}
+
+	{ // stacked area plot
+		p := plot.New()
+		p.Theme.Palette = plot.OkabeIto
+
+		stack := plot.NewVStack()
+		stack.Padding = defaultPadding
+
+		p.Add(stack)
+		baselines := []plot.StackBaseline{plot.StackZero, plot.StackSymmetric, plot.StackWiggle}
+		for i, baseline := range baselines {
+			const N = 64
+			x := make([]float64, N)
+			for k := range x {
+				x[k] = float64(k)
+			}
+
+			area := plot.NewStackedArea(x)
+			area.Baseline = baseline
+			area.Normalized = baseline == plot.StackZero
+			for k, name := range []string{"Parse", "Check", "Compile"} {
+				values := make([]float64, N)
+				offset := float64(k) * 2
+				for j := range values {
+					values[j] = 1 + math.Sin(float64(j)/8+offset) + rand.Float64()*0.3
+				}
+				area.Add(name, values)
+			}
+
+			stack.AddGroup(
+				plot.NewGrid(),
+				plot.NewGizmo(),
+				area,
+				plot.NewTickLabels(),
+				plot.NewXLabel("Baseline "+strconv.Itoa(i+1)),
+			)
+		}
+
+		svg := plotsvg.New(800, float64(200*len(baselines)))
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("area.svg", svg.Bytes(), 0755)
+
+		p.Theme = plot.NewDarkTheme()
+		svg = plotsvg.New(800, float64(200*len(baselines)))
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("area-dark.svg", svg.Bytes(), 0755)
+	}
+
+	{ // heatmap plot
+		p := plot.New()
+
+		flex := plot.NewHFlex()
+		flex.Padding = defaultPadding
+		p.Add(flex)
+
+		sizes := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8}
+		procs := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8}
+		values := make([][]float64, len(procs)-1)
+		for row := range values {
+			values[row] = make([]float64, len(sizes)-1)
+			for col := range values[row] {
+				values[row][col] = math.Pow(4, float64(col)) / float64(row+1) * (1 + rand.Float64()*0.2)
+			}
+		}
+
+		heatmap := plot.NewHeatmap("ns/op", sizes, procs, values)
+		heatmap.Scale.Log = true
+
+		xaxis := plot.NewXAxisDecoration("size", "log2 bytes")
+		xaxis.Ticks = plot.TicksIn
+		yaxis := plot.NewYAxisDecoration("GOMAXPROCS", "")
+		yaxis.Ticks = plot.TicksIn
+
+		flex.AddGroup(0,
+			heatmap,
+			xaxis, yaxis,
+			plot.NewTickLabels(),
+		)
+		flex.Add(80, plot.NewColorbar(heatmap))
+
+		svg := plotsvg.New(600, 400)
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("heatmap.svg", svg.Bytes(), 0755)
+	}
+
+	{ // raster heatmap plot
+		p := plot.New()
+
+		flex := plot.NewHFlex()
+		flex.Padding = defaultPadding
+		p.Add(flex)
+
+		const rows, columns = 200, 300
+		values := make([][]float64, rows)
+		for row := range values {
+			values[row] = make([]float64, columns)
+			for col := range values[row] {
+				x, y := float64(col)/columns*8, float64(row)/rows*6
+				values[row][col] = math.Sin(x)*math.Cos(y) + rand.Float64()*0.2
+			}
+		}
+
+		heatmap := plot.NewHeatmap("value", nil, nil, values)
+		heatmap.Raster = true
+
+		flex.AddGroup(0,
+			heatmap,
+			plot.NewGizmo(),
+			plot.NewTickLabels(),
+		)
+		flex.Add(80, plot.NewColorbar(heatmap))
+
+		svg := plotsvg.New(600, 400)
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("heatmap-raster.svg", svg.Bytes(), 0755)
+	}
+
+	{ // radar plot
+		p := plot.New()
+		p.Padding = defaultPadding
+		p.Title = "Relative cost"
+		p.Polar = plot.NewPolar()
+
+		radar := plot.NewRadar("time", "allocs", "bytes", "p99")
+		radar.Add("map", 1.0, 0.8, 1.0, 0.9)
+		radar.Add("slice", 0.4, 0.3, 0.5, 0.6)
+		radar.Add("btree", 0.7, 1.0, 0.6, 0.5)
+		p.X = radar.Axis()
+		p.Y.Min, p.Y.Max = 0, 1
+
+		p.AddGroup(
+			plot.NewPolarGrid(),
+			radar,
+			plot.NewPolarTickLabels(),
+		)
+
+		svg := plotsvg.New(400, 400)
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("radar.svg", svg.Bytes(), 0755)
+	}
+
+	{ // polar line plot
+		p := plot.New()
+		p.Padding = defaultPadding
+		p.Polar = plot.NewPolar()
+		p.X.Min, p.X.Max = 0, 360
+
+		rose := []plot.Point{}
+		for angle := 0.0; angle <= 360; angle += 2 {
+			rose = append(rose, plot.P(angle, math.Abs(math.Cos(angle*math.Pi/180*3))))
+		}
+
+		p.AddGroup(
+			plot.NewPolarGrid(),
+			plot.NewLine("rose", rose),
+			plot.NewPolarTickLabels(),
+		)
+
+		svg := plotsvg.New(400, 400)
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("polar.svg", svg.Bytes(), 0755)
+	}
+
+	{ // twin axis plot
+		p := plot.New()
+		p.Padding = defaultPadding
+
+		sizes := []float64{}
+		nsop := []float64{}
+		allocs := []float64{}
+		for size := 1.0; size <= 1024; size *= 2 {
+			sizes = append(sizes, size)
+			nsop = append(nsop, 50+size*3.2*(1+rand.Float64()*0.1))
+			allocs = append(allocs, math.Ceil(math.Log2(size+1)))
+		}
+
+		timing := plot.NewLine("ns/op", plot.Points(sizes, nsop))
+		timing.Stroke = color.NRGBA{200, 0, 0, 255}
+		timing.Interpolation = plot.InterpolateMonotone
+
+		allocations := plot.NewLine("allocs/op", plot.Points(sizes, allocs))
+		allocations.Stroke = color.NRGBA{0, 0, 200, 255}
+		allocations.Dash = []plot.Length{4, 2}
+
+		twin := plot.NewTwinY(allocations)
+		twin.AlignTicks = true
+
+		p.AddGroup(
+			plot.NewGrid(),
+			timing,
+			twin,
+			plot.NewTickLabels(),
+			plot.NewXAxisDecoration("input size", "bytes"),
+			plot.NewYAxisDecoration("time", "ns/op"),
+			twin.TickLabels(),
+			twin.YAxisDecoration("allocations", "allocs/op"),
+		)
+
+		svg := plotsvg.New(800, 300)
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("twin.svg", svg.Bytes(), 0755)
+	}
+
+	{ // annotated plot
+		p := plot.New()
+		p.Padding = defaultPadding
+		p.Title = "Request latency"
+		p.Subtitle = "per build, milliseconds"
+		p.Caption = "Builds 20-30 were made during the release freeze."
+
+		builds := []float64{}
+		latency := []float64{}
+		for build := 0.0; build < 60; build++ {
+			value := 80 + rand.Float64()*10
+			if build >= 40 {
+				value += 25
+			}
+			builds = append(builds, build)
+			latency = append(latency, value)
+		}
+
+		regression := plot.NewCallout(plot.P(40, latency[40]), "regression here")
+		regression.Offset = plot.P(-40, -20)
+
+		p.AddGroup(
+			plot.NewGrid(),
+			plot.NewXSpan(20, 30, "freeze"),
+			plot.NewHLine(100, "SLO"),
+			plot.NewVLine(40, "v1.2"),
+			plot.NewLine("latency", plot.Points(builds, latency)),
+			regression,
+			plot.NewHighlight(plot.P(59, latency[59]), "latest"),
+			plot.NewTickLabels(),
+		)
+
+		svg := plotsvg.New(800, 300)
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("annotations.svg", svg.Bytes(), 0755)
+	}
+
+	{ // facet plot
+		p := plot.New()
+
+		facet := plot.NewFacet(len(datasets), 3)
+		facet.ShareX = true
+		facet.ShareY = true
+		facet.Padding = plot.R(4, 8, 4, 8)
+		p.Add(facet)
+
+		for i, dataset := range datasets {
+			name := "Case " + strconv.Itoa(i+1)
+			facet.Add(name+" Red", plot.NewGrid(), plot.NewDensity("Red", dataset.Red))
+			facet.Add(name+" Green", plot.NewGrid(), plot.NewDensity("Green", dataset.Green))
+			facet.Add(name+" Blue", plot.NewGrid(), plot.NewDensity("Blue", dataset.Blue))
+		}
+
+		svg := plotsvg.New(800, 600)
+		p.Layout(svg)
+		p.Draw(svg)
+		ioutil.WriteFile("facet.svg", svg.Bytes(), 0755)
 	}
 
 	{ // line plot
 		p := plot.New()
 		stack := plot.NewHStack()
-		stack.Margin = defaultMargin
+		stack.Padding = defaultPadding
 		p.Add(stack)
 
 		sizes := []int{1, 2, 4, 8, 1024, 8196}
@@ -195,6 +511,7 @@ func main() {
 		}
 
 		svg := plotsvg.New(800, float64(200*len(datasets)))
+		p.Layout(svg)
 		p.Draw(svg)
 		ioutil.WriteFile("line-stack.svg", svg.Bytes(), 0755)
 	}
@@ -202,7 +519,7 @@ func main() {
 	{ // bar plot
 		p := plot.New()
 		stack := plot.NewHStack()
-		stack.Margin = defaultMargin
+		stack.Padding = defaultPadding
 		p.Add(stack)
 
 		sizes := []int{1, 2, 4, 8, 1024, 8196}
@@ -238,6 +555,7 @@ func main() {
 		}
 
 		svg := plotsvg.New(800, float64(200*len(datasets)))
+		p.Layout(svg)
 		p.Draw(svg)
 		ioutil.WriteFile("bar-chart.svg", svg.Bytes(), 0755)
 
@@ -247,6 +565,7 @@ func main() {
 		//p.X.Transform = plot.NewLog1pTransform(2)
 
 		svg = plotsvg.New(800, float64(200*len(datasets)))
+		p.Layout(svg)
 		p.Draw(svg)
 		ioutil.WriteFile("bar-chart-dynamic.svg", svg.Bytes(), 0755)
 	}
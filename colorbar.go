@@ -0,0 +1,147 @@
+package plot
+
+import (
+	"fmt"
+	"math"
+)
+
+// ColorScaler is an element that maps values to colors.
+type ColorScaler interface {
+	ColorScale() *ColorScale
+}
+
+// ColorScale returns the scale itself.
+func (scale *ColorScale) ColorScale() *ColorScale { return scale }
+
+// Colorbar implements drawing a vertical value to color scale.
+type Colorbar struct {
+	Source ColorScaler
+	// Axis configures the ticks, Min and Max are taken from the scale.
+	Axis *Axis
+	// Width is the width of the bar.
+	Width Length
+
+	Style Style
+}
+
+// NewColorbar creates a colorbar for the source, e.g. a heatmap.
+func NewColorbar(source ColorScaler) *Colorbar {
+	axis := NewAxis()
+	axis.MinorTicks = 0
+	return &Colorbar{
+		Source: source,
+		Axis:   axis,
+		Width:  16,
+	}
+}
+
+// ticks calculates the ticks for the scale.
+func (colorbar *Colorbar) ticks(scale *ColorScale) []Tick {
+	axis := *colorbar.Axis
+	if !scale.Log {
+		axis.Min, axis.Max = scale.Min, scale.Max
+		return axis.Ticks.Ticks(&axis)
+	}
+
+	axis.Min, axis.Max = math.Log10(scale.Min), math.Log10(scale.Max)
+	if _, automatic := axis.Ticks.(AutomaticTicks); automatic {
+		return logTicks(axis.Min, axis.Max)
+	}
+
+	ticks := axis.Ticks.Ticks(&axis)
+	for i := range ticks {
+		ticks[i].Value = math.Pow(10, ticks[i].Value)
+		if ticks[i].Label != "" {
+			ticks[i].Label = fmt.Sprintf("%.3g", ticks[i].Value)
+		}
+	}
+	return ticks
+}
+
+// logTicks calculates major ticks at powers of ten and minor ticks in between.
+func logTicks(low, high float64) []Tick {
+	if low > high {
+		low, high = high, low
+	}
+
+	ticks := []Tick{}
+	for power := math.Floor(low); power <= math.Ceil(high); power++ {
+		base := math.Pow(10, power)
+		if low <= power && power <= high {
+			ticks = append(ticks, Tick{
+				Value: base,
+				Label: fmt.Sprintf("%g", base),
+			})
+		}
+		for k := 2.0; k < 10; k++ {
+			if v := math.Log10(base * k); low <= v && v <= high {
+				ticks = append(ticks, Tick{Minor: true, Value: base * k})
+			}
+		}
+	}
+	return ticks
+}
+
+// Draw draws the element to canvas.
+func (colorbar *Colorbar) Draw(plot *Plot, canvas Canvas) {
+	scale := colorbar.Source.ColorScale()
+	if math.IsNaN(scale.Min) || math.IsNaN(scale.Max) {
+		return
+	}
+
+	bounds := canvas.Bounds()
+	size := bounds.Size()
+	width := colorbar.Width
+	if width <= 0 {
+		width = 16
+	}
+
+	// position of a normalized value, the maximum is at the top
+	position := func(t float64) Length {
+		return lerp(t, size.Y, 0)
+	}
+
+	const steps = 64
+	for i := 0; i < steps; i++ {
+		t0, t1 := float64(i)/steps, float64(i+1)/steps
+		colormap := scale.Colormap
+		if colormap == nil {
+			colormap = DefaultColormap
+		}
+		canvas.Rect(R(0, position(t0), width, position(t1)), &Style{
+			Fill:  colormap.At((t0 + t1) * 0.5),
+			Class: "colorbar",
+		})
+	}
+	canvas.Rect(R(0, 0, width, size.Y), &Style{
+		Stroke: plot.Theme.Line.Stroke,
+		Size:   1,
+		Class:  "colorbar-border",
+	})
+
+	style := colorbar.Style
+	if style.IsZero() {
+		style = plot.Theme.FontSmall
+	}
+	style.Origin = Point{-1, 0}
+
+	tickStyle := &Style{
+		Stroke: plot.Theme.Line.Stroke,
+		Size:   1,
+	}
+
+	for _, tick := range colorbar.ticks(scale) {
+		y := position(scale.normalize(tick.Value))
+		if tick.Value < math.Min(scale.Min, scale.Max) || tick.Value > math.Max(scale.Min, scale.Max) {
+			continue
+		}
+		if tick.Minor {
+			canvas.Poly(Ps(width, y, width+2, y), tickStyle)
+			continue
+		}
+		canvas.Poly(Ps(width, y, width+4, y), tickStyle)
+		if tick.Label != "" {
+			canvas.Text(tick.Label, P(width+6, y), &style)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package plot
+
+import (
+	"image/color"
+	"math"
+)
+
+// Colormap maps a normalized value to a color.
+type Colormap interface {
+	// At returns the color for t in range 0..1.
+	At(t float64) color.Color
+}
+
+// LinearColormap interpolates between evenly spaced colors.
+type LinearColormap []color.Color
+
+// At returns the color for t in range 0..1.
+func (colors LinearColormap) At(t float64) color.Color {
+	if len(colors) == 0 {
+		return nil
+	}
+	if len(colors) == 1 || math.IsNaN(t) || t <= 0 {
+		return colors[0]
+	}
+	if t >= 1 {
+		return colors[len(colors)-1]
+	}
+
+	p := t * float64(len(colors)-1)
+	i := int(p)
+	return lerpColor(p-float64(i), colors[i], colors[i+1])
+}
+
+// DefaultColormap is used when a colormap has not been specified.
+var DefaultColormap Colormap = Viridis
+
+// lerpColor linearly interpolates between colors a and b.
+func lerpColor(t float64, a, b color.Color) color.Color {
+	ca, cb := color.NRGBAModel.Convert(a).(color.NRGBA), color.NRGBAModel.Convert(b).(color.NRGBA)
+	mix := func(a, b uint8) uint8 {
+		return uint8(math.Round(lerp(t, float64(a), float64(b))))
+	}
+	return color.NRGBA{
+		R: mix(ca.R, cb.R),
+		G: mix(ca.G, cb.G),
+		B: mix(ca.B, cb.B),
+		A: mix(ca.A, cb.A),
+	}
+}
+
+// ColorScale maps values to colors using a colormap.
+type ColorScale struct {
+	// Min, Max are the value range, NaN values are automatically detected.
+	Min, Max float64
+	// Log enables logarithmic scaling, non-positive values are clamped to Min.
+	Log bool
+	// Colormap is used for mapping, DefaultColormap when nil.
+	Colormap Colormap
+}
+
+// NewColorScale creates a new automatically detected linear color scale.
+func NewColorScale() *ColorScale {
+	return &ColorScale{
+		Min: math.NaN(),
+		Max: math.NaN(),
+	}
+}
+
+// Include ensures that min and max are in the range.
+func (scale *ColorScale) Include(min, max float64) {
+	if scale.Log {
+		if min <= 0 {
+			min = max
+		}
+		if max <= 0 {
+			return
+		}
+	}
+	if math.IsNaN(scale.Min) || min < scale.Min {
+		scale.Min = min
+	}
+	if math.IsNaN(scale.Max) || max > scale.Max {
+		scale.Max = max
+	}
+}
+
+// normalize converts value to range 0..1.
+func (scale *ColorScale) normalize(v float64) float64 {
+	low, high := scale.Min, scale.Max
+	if scale.Log {
+		v = math.Log10(math.Max(v, low))
+		low, high = math.Log10(low), math.Log10(high)
+	}
+	if high == low {
+		return 0.5
+	}
+	return math.Max(0, math.Min(1, invlerp(v, low, high)))
+}
+
+// Color returns the color for value v.
+func (scale *ColorScale) Color(v float64) color.Color {
+	colormap := scale.Colormap
+	if colormap == nil {
+		colormap = DefaultColormap
+	}
+	return colormap.At(scale.normalize(v))
+}
@@ -0,0 +1,263 @@
+package plot
+
+import "math"
+
+// Side identifies a side of the plot area.
+type Side byte
+
+const (
+	// SideBottom is the bottom side, using X axis.
+	SideBottom Side = iota
+	// SideLeft is the left side, using Y axis.
+	SideLeft
+	// SideTop is the top side, using X axis.
+	SideTop
+	// SideRight is the right side, using Y axis.
+	SideRight
+)
+
+// Horizontal returns whether the side is at the bottom or top.
+func (side Side) Horizontal() bool { return side == SideBottom || side == SideTop }
+
+// axis returns the axis of the side.
+func (side Side) axis(plot *Plot) *Axis {
+	if side.Horizontal() {
+		return plot.X
+	}
+	return plot.Y
+}
+
+// length returns the length of the side.
+func (side Side) length(size Point) Length {
+	if side.Horizontal() {
+		return size.X
+	}
+	return size.Y
+}
+
+// point converts position along the side and distance outward from the
+// plot area to canvas space.
+func (side Side) point(size Point, along, outward Length) Point {
+	switch side {
+	case SideBottom:
+		return Point{along, size.Y + outward}
+	case SideTop:
+		return Point{along, -outward}
+	case SideLeft:
+		return Point{-outward, along}
+	case SideRight:
+		return Point{size.X + outward, along}
+	}
+	panic("invalid side")
+}
+
+// outward returns how far r extends outward from the side at distance zero.
+func (side Side) outward(r Rect) Length {
+	switch side {
+	case SideBottom:
+		return r.Max.Y
+	case SideTop:
+		return -r.Min.Y
+	case SideLeft:
+		return -r.Min.X
+	case SideRight:
+		return r.Max.X
+	}
+	panic("invalid side")
+}
+
+// TickDirection determines the direction of tick marks.
+type TickDirection byte
+
+const (
+	// TicksOut draws tick marks outside of the plot area.
+	TicksOut TickDirection = iota
+	// TicksIn draws tick marks inside of the plot area.
+	TicksIn
+	// TicksCross draws tick marks crossing the spine.
+	TicksCross
+	// TicksNone disables tick marks.
+	TicksNone
+)
+
+// extent returns the inward and outward length of a tick mark.
+func (dir TickDirection) extent(length Length) (in, out Length) {
+	switch dir {
+	case TicksOut:
+		return 0, length
+	case TicksIn:
+		return length, 0
+	case TicksCross:
+		return length, length
+	}
+	return 0, 0
+}
+
+// AxisDecoration implements drawing an axis spine, tick marks and title.
+type AxisDecoration struct {
+	Side Side
+
+	// Spine enables drawing the axis line.
+	Spine bool
+	// Ticks determines the direction of tick marks.
+	Ticks TickDirection
+	// MajorLength, MinorLength are the lengths of the tick marks.
+	MajorLength Length
+	MinorLength Length
+
+	// Title is the axis title, which is followed by Unit in parentheses.
+	Title string
+	Unit  string
+	// TitleOffset is the distance of the title from the spine,
+	// when zero, it is placed after the tick marks and tick labels.
+	TitleOffset Length
+
+	Style      Style
+	TitleStyle Style
+}
+
+// NewAxisDecoration creates a new axis decoration for the specified side.
+func NewAxisDecoration(side Side, title, unit string) *AxisDecoration {
+	return &AxisDecoration{
+		Side:        side,
+		Spine:       true,
+		Ticks:       TicksOut,
+		MajorLength: 6,
+		MinorLength: 3,
+		Title:       title,
+		Unit:        unit,
+	}
+}
+
+// NewXAxisDecoration creates a new axis decoration at the bottom.
+func NewXAxisDecoration(title, unit string) *AxisDecoration {
+	return NewAxisDecoration(SideBottom, title, unit)
+}
+
+// NewYAxisDecoration creates a new axis decoration on the left.
+func NewYAxisDecoration(title, unit string) *AxisDecoration {
+	return NewAxisDecoration(SideLeft, title, unit)
+}
+
+// style returns the style used for spine and tick marks.
+func (axis *AxisDecoration) style(plot *Plot) *Style {
+	if axis.Style.IsZero() {
+		return &plot.Theme.Axis
+	}
+	return &axis.Style
+}
+
+// title returns the title text and style.
+func (axis *AxisDecoration) title(plot *Plot) (string, *Style) {
+	text := axis.Title
+	if axis.Unit != "" {
+		if text == "" {
+			text = axis.Unit
+		} else {
+			text += " (" + axis.Unit + ")"
+		}
+	}
+
+	style := axis.TitleStyle
+	if style.IsZero() {
+		style = plot.Theme.Font
+	}
+
+	switch axis.Side {
+	case SideBottom:
+		style.Origin = Point{0, -1}
+	case SideTop:
+		style.Origin = Point{0, 1}
+	case SideLeft:
+		style.Origin = Point{0, 1}
+		style.Rotation = -math.Pi / 2
+	case SideRight:
+		style.Origin = Point{0, 1}
+		style.Rotation = math.Pi / 2
+	}
+
+	return text, &style
+}
+
+// titlePosition calculates where the title is drawn.
+func (axis *AxisDecoration) titlePosition(plot *Plot, measurer TextMeasurer, size Point) Point {
+	offset := axis.TitleOffset
+	if offset == 0 {
+		_, out := axis.Ticks.extent(axis.MajorLength)
+		offset = out
+
+		// place after the tick labels
+		ax := axis.Side.axis(plot)
+		labelStyle := &plot.Theme.FontSmall
+		for _, tick := range ax.Ticks.Ticks(ax) {
+			if tick.Label != "" {
+				r := measurer.MeasureText(tick.Label, labelStyle)
+				offset = math.Max(offset, axis.Side.outward(r))
+			}
+		}
+		offset += defaultLabelSpacing
+	}
+
+	return axis.Side.point(size, axis.Side.length(size)*0.5, offset)
+}
+
+// Draw draws the element to canvas.
+func (axis *AxisDecoration) Draw(plot *Plot, canvas Canvas) {
+	size := canvas.Bounds().Size()
+	style := axis.style(plot)
+	side := axis.Side
+
+	if axis.Spine {
+		length := side.length(size)
+		canvas.Poly([]Point{
+			side.point(size, 0, 0),
+			side.point(size, length, 0),
+		}, style)
+	}
+
+	if axis.Ticks != TicksNone {
+		ax := side.axis(plot)
+		length := side.length(size)
+		for _, tick := range ax.Ticks.Ticks(ax) {
+			p := ax.ToCanvas(tick.Value, 0, length)
+			if p < 0 || p > length {
+				continue
+			}
+
+			tickLength := axis.MajorLength
+			if tick.Minor {
+				tickLength = axis.MinorLength
+			}
+			in, out := axis.Ticks.extent(tickLength)
+			canvas.Poly([]Point{
+				side.point(size, p, -in),
+				side.point(size, p, out),
+			}, style)
+		}
+	}
+
+	if text, titleStyle := axis.title(plot); text != "" {
+		at := axis.titlePosition(plot, textMeasurer(canvas), size)
+		canvas.Text(text, at, titleStyle)
+	}
+}
+
+// Overflow calculates how much the element extends beyond bounds on each side.
+func (axis *AxisDecoration) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	size := bounds.Size()
+
+	_, out := axis.Ticks.extent(math.Max(axis.MajorLength, axis.MinorLength))
+	extent := Rect{
+		Min: axis.Side.point(size, 0, out),
+		Max: axis.Side.point(size, axis.Side.length(size), out),
+	}
+	overflow := overflowOf(bounds.Zero(), Rect{extent.Min.Min(extent.Max), extent.Min.Max(extent.Max)})
+
+	if text, titleStyle := axis.title(plot); text != "" {
+		at := axis.titlePosition(plot, measurer, size)
+		r := measurer.MeasureText(text, titleStyle).Offset(at)
+		overflow = maxInsets(overflow, overflowOf(bounds.Zero(), r))
+	}
+
+	return overflow
+}
@@ -61,25 +61,55 @@ func (line *Density) Draw(plot *Plot, canvas Canvas) {
 		// default to 4px wide kernel
 		kernel = 4 * (x.Max - x.Min) / size.X
 	}
-	invkernel := 1 / kernel
 
 	points := []Point{}
-	if line.Fill != nil {
+	if line.HasFill() {
 		points = append(points, Point{xmin, 0})
 	}
 
+	samples, maxy := sampleDensity(line.Data, x, size.X, kernel)
+	points = append(points, samples...)
+
+	if line.HasFill() {
+		points = append(points,
+			Point{xmax, 0},
+			Point{xmin, 0},
+		)
+	}
+
+	scale := kernel / float64(len(line.Data))
+	if line.Normalized {
+		scale = 1 / maxy
+	}
+
+	for i := range points {
+		points[i].Y = y.ToCanvas(points[i].Y*scale, 0, size.Y)
+	}
+
+	style := &line.Style
+	if style.IsZero() {
+		style = plot.autoStyle(line, &plot.Theme.Line)
+	}
+	canvas.Poly(points, plot.resolveStyle(style, canvas.Bounds()))
+}
+
+// sampleDensity calculates the kernel density of sorted data at every half pixel
+// along the x axis of the given width. The resulting points have X in canvas space and
+// Y as the unnormalized density.
+func sampleDensity(data []float64, x *Axis, width, kernel float64) (points []Point, maxy float64) {
+	invkernel := 1 / kernel
+
 	index := 0
 	previousLow := math.Inf(-1)
-	maxy := 0.0
-	for screenX := 0.0; screenX < size.X; screenX += 0.5 {
-		center := x.FromCanvas(screenX, 0, size.X)
+	for screenX := 0.0; screenX < width; screenX += 0.5 {
+		center := x.FromCanvas(screenX, 0, width)
 		low, high := center-kernel, center+kernel
 
 		if low < previousLow {
-			index = sort.SearchFloat64s(line.Data, low)
+			index = sort.SearchFloat64s(data, low)
 		} else {
-			for ; index < len(line.Data); index++ {
-				if line.Data[index] >= low {
+			for ; index < len(data); index++ {
+				if data[index] >= low {
 					break
 				}
 			}
@@ -87,7 +117,7 @@ func (line *Density) Draw(plot *Plot, canvas Canvas) {
 		previousLow = low
 
 		sample := 0.0
-		for _, value := range line.Data[index:] {
+		for _, value := range data[index:] {
 			if value > high {
 				break
 			}
@@ -101,26 +131,5 @@ func (line *Density) Draw(plot *Plot, canvas Canvas) {
 			Y: sample,
 		})
 	}
-
-	if line.Fill != nil {
-		points = append(points,
-			Point{xmax, 0},
-			Point{xmin, 0},
-		)
-	}
-
-	scale := kernel / float64(len(line.Data))
-	if line.Normalized {
-		scale = 1 / maxy
-	}
-
-	for i := range points {
-		points[i].Y = y.ToCanvas(points[i].Y*scale, 0, size.Y)
-	}
-
-	if !line.Style.IsZero() {
-		canvas.Poly(points, &line.Style)
-	} else {
-		canvas.Poly(points, &plot.Theme.Line)
-	}
+	return points, maxy
 }
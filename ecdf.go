@@ -0,0 +1,168 @@
+package plot
+
+import (
+	"image/color"
+	"math"
+	"sort"
+)
+
+// ECDF implements empirical cumulative distribution function plot.
+type ECDF struct {
+	Style
+	Label string
+
+	// Confidence is the confidence level of the Dvoretzky–Kiefer–Wolfowitz
+	// band drawn around the line, e.g. 0.95. Zero disables the band.
+	Confidence float64
+	// Band is the style for the confidence band.
+	Band Style
+
+	Data []float64 // sorted
+
+	hitPoints ecdfCache
+}
+
+// ecdfCache caches the distribution points for hit testing,
+// it's invalidated when Data is replaced.
+type ecdfCache struct {
+	first  *float64
+	length int
+	points []Point
+}
+
+// NewECDF creates an empirical cumulative distribution plot from the given values.
+// Non-finite values are ignored.
+func NewECDF(label string, values []float64) *ECDF {
+	data := make([]float64, 0, len(values))
+	for _, v := range values {
+		if isFinite(v) {
+			data = append(data, v)
+		}
+	}
+	sort.Float64s(data)
+	return &ECDF{
+		Label: label,
+		Data:  data,
+	}
+}
+
+// Stats calculates element statistics.
+func (ecdf *ECDF) Stats() Stats {
+	min, median, max := math.NaN(), math.NaN(), math.NaN()
+
+	n := len(ecdf.Data)
+	if n > 0 {
+		min = ecdf.Data[0]
+		median = ecdf.Data[n/2]
+		max = ecdf.Data[n-1]
+	}
+
+	return Stats{
+		Min:    Point{min, 0},
+		Center: Point{median, 0.5},
+		Max:    Point{max, 1},
+	}
+}
+
+// points returns the cumulative distribution offset by delta and clamped to [0, 1].
+func (ecdf *ECDF) points(delta float64) []Point {
+	n := len(ecdf.Data)
+	if n == 0 {
+		return nil
+	}
+
+	clamp := func(v float64) float64 { return math.Max(0, math.Min(1, v)) }
+
+	points := make([]Point, 0, n+1)
+	points = append(points, Point{ecdf.Data[0], clamp(delta)})
+	for i, v := range ecdf.Data {
+		points = append(points, Point{v, clamp(float64(i+1)/float64(n) + delta)})
+	}
+	return points
+}
+
+// staircase returns the right-continuous steps of the distribution offset by delta,
+// each value rises at the sample.
+func (ecdf *ECDF) staircase(delta float64) []Point {
+	points := ecdf.points(delta)
+	if len(points) == 0 {
+		return nil
+	}
+	// the first point starts the rise at the first sample
+	return append([]Point{points[0]}, StepPost.expand(points[1:])...)
+}
+
+// bandWidth calculates the half-width of the DKW confidence band.
+func (ecdf *ECDF) bandWidth() float64 {
+	alpha := 1 - ecdf.Confidence
+	return math.Sqrt(math.Log(2/alpha) / (2 * float64(len(ecdf.Data))))
+}
+
+// Draw draws the element to canvas.
+func (ecdf *ECDF) Draw(plot *Plot, canvas Canvas) {
+	if len(ecdf.Data) == 0 {
+		return
+	}
+
+	canvas = canvas.Clip(canvas.Bounds())
+	bounds := canvas.Bounds()
+
+	// dense staircases are reduced to a few points per pixel column,
+	// which doesn't apply in polar mode
+	simplifier := MinMaxSimplifier{}
+	staircase := func(delta float64) []Point {
+		points := plot.project(ecdf.staircase(delta), bounds)
+		if plot.Polar != nil {
+			return points
+		}
+		return simplifier.Simplify(points)
+	}
+
+	style := &ecdf.Style
+	if style.IsZero() {
+		style = plot.autoStyle(ecdf, &plot.Theme.Line)
+	}
+
+	if ecdf.Confidence > 0 && ecdf.Confidence < 1 {
+		width := ecdf.bandWidth()
+		upper := staircase(width)
+		lower := staircase(-width)
+
+		band := make([]Point, 0, len(upper)+len(lower))
+		band = append(band, upper...)
+		for i := len(lower) - 1; i >= 0; i-- {
+			band = append(band, lower[i])
+		}
+
+		bandStyle := ecdf.Band
+		if bandStyle.IsZero() {
+			bandStyle.Fill = fadeColor(style.Stroke, 40)
+		}
+		canvas.Poly(band, &bandStyle)
+	}
+
+	canvas.Poly(staircase(0), style)
+}
+
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (ecdf *ECDF) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	cache := &ecdf.hitPoints
+	if len(ecdf.Data) == 0 {
+		return Hit{}, false
+	}
+	if cache.first != &ecdf.Data[0] || cache.length != len(ecdf.Data) {
+		cache.first, cache.length = &ecdf.Data[0], len(ecdf.Data)
+		cache.points = ecdf.points(0)
+	}
+	return hitTestPoints(ecdf, ecdf.Label, cache.points, nil, plot, bounds, at)
+}
+
+// fadeColor returns the color with the specified alpha.
+func fadeColor(c color.Color, alpha uint8) color.Color {
+	if c == nil {
+		c = color.Black
+	}
+	faded := color.NRGBAModel.Convert(c).(color.NRGBA)
+	faded.A = alpha
+	return faded
+}
@@ -0,0 +1,55 @@
+package plot
+
+import "testing"
+
+func TestECDFStaircase(t *testing.T) {
+	ecdf := NewECDF("", []float64{3, 1, 2})
+
+	x, y := NewAxis(), NewAxis()
+	x.Min, x.Max = 0, 4
+	y.Min, y.Max = 0, 1
+	points := project(ecdf.staircase(0), x, y, R(0, 0, 4, 3))
+
+	expected := []Point{
+		{1, 0}, {1, 1},
+		{2, 1}, {2, 2},
+		{3, 2}, {3, 3},
+	}
+	if len(points) != len(expected) {
+		t.Fatalf("got %v, expected %v", points, expected)
+	}
+	for i, p := range points {
+		if !approxEqualPoint(p, expected[i]) {
+			t.Errorf("%d: got %v, expected %v", i, p, expected[i])
+		}
+	}
+}
+
+func approxEqualPoint(a, b Point) bool {
+	const epsilon = 1e-9
+	d := a.Sub(b)
+	return -epsilon < d.X && d.X < epsilon && -epsilon < d.Y && d.Y < epsilon
+}
+
+func TestECDFHitTestCache(t *testing.T) {
+	p := New()
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 1
+	bounds := R(0, 0, 100, 100)
+
+	ecdf := NewECDF("", []float64{1, 2, 3, 4})
+	hit, ok := ecdf.HitTest(p, bounds, Point{20, 50})
+	if !ok || hit.Value != (Point{2, 0.5}) {
+		t.Fatalf("got %v %v, expected the second value", hit, ok)
+	}
+	points := ecdf.hitPoints.points
+	if _, ok := ecdf.HitTest(p, bounds, Point{30, 25}); !ok || &ecdf.hitPoints.points[0] != &points[0] {
+		t.Errorf("points weren't reused")
+	}
+
+	ecdf.Data = []float64{5, 6}
+	hit, ok = ecdf.HitTest(p, bounds, Point{50, 50})
+	if !ok || hit.Value != (Point{5, 0.5}) {
+		t.Errorf("got %v %v after replacing data, expected the first value", hit, ok)
+	}
+}
@@ -22,6 +22,11 @@ func (els Elements) Draw(plot *Plot, canvas Canvas) {
 	}
 }
 
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (els Elements) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	return hitTestElements(plot, els, bounds, at)
+}
+
 // Margin is a collection which is drawn with a margin.
 type Margin struct {
 	Amount Rect
@@ -39,9 +44,48 @@ func (margin *Margin) Draw(plot *Plot, canvas Canvas) {
 	margin.Elements.Draw(plot, canvas.Context(bounds))
 }
 
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (margin *Margin) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	return margin.Elements.HitTest(plot, bounds.Inset(margin.Amount), at)
+}
+
+// Overflow calculates how much the element extends beyond bounds on each side.
+func (margin *Margin) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	inner := bounds.Inset(margin.Amount)
+	overflow := elementOverflow(plot, measurer, margin.Elements, inner.Zero())
+	return overflowOf(bounds, Rect{inner.Min.Sub(overflow.Min), inner.Max.Add(overflow.Max)})
+}
+
+// Layout updates the margins of the children such that they fit into bounds.
+func (margin *Margin) Layout(plot *Plot, measurer TextMeasurer, bounds Rect) {
+	layoutElement(plot, measurer, margin.Elements, bounds.Inset(margin.Amount).Zero())
+}
+
+// OpacityGroup is a collection which is composited with a single opacity.
+//
+// Unlike setting the alpha of each color, overlapping elements inside
+// the group don't show through each other. Canvases that don't implement
+// OpacityCanvas draw the group opaque.
+type OpacityGroup struct {
+	Opacity float64
+	Elements
+}
+
+// NewOpacityGroup creates a group of elements drawn with opacity.
+func NewOpacityGroup(opacity float64, els ...Element) *OpacityGroup {
+	return &OpacityGroup{Opacity: opacity, Elements: Elements(els)}
+}
+
+// Draw draws the elements with the group opacity.
+func (group *OpacityGroup) Draw(plot *Plot, canvas Canvas) {
+	group.Elements.Draw(plot, WithOpacity(canvas, group.Opacity))
+}
+
 // VStack implements vertically stacked elements.
 type VStack struct {
 	Margin Rect
+	// Padding is the space around each element in addition to the overflow, see Layout.
+	Padding Rect
 	Elements
 }
 
@@ -62,9 +106,29 @@ func (stack *VStack) Draw(plot *Plot, canvas Canvas) {
 	}
 }
 
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (stack *VStack) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	for i, el := range stack.Elements {
+		block := bounds.Row(i, len(stack.Elements))
+		if block.Min.Y <= at.Y && at.Y < block.Max.Y {
+			return hitTestElements(plot, []Element{el}, block.Inset(stack.Margin), at)
+		}
+	}
+	return Hit{}, false
+}
+
+// Layout updates the margin such that the elements fit into bounds.
+func (stack *VStack) Layout(plot *Plot, measurer TextMeasurer, bounds Rect) {
+	stack.Margin = layoutMargin(plot, measurer, stack.Padding, stack.Elements, func(i int) Rect {
+		return bounds.Row(i, len(stack.Elements))
+	})
+}
+
 // HStack implements horizontally stacked elements.
 type HStack struct {
 	Margin Rect
+	// Padding is the space around each element in addition to the overflow, see Layout.
+	Padding Rect
 	Elements
 }
 
@@ -85,9 +149,29 @@ func (stack *HStack) Draw(plot *Plot, canvas Canvas) {
 	}
 }
 
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (stack *HStack) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	for i, el := range stack.Elements {
+		block := bounds.Column(i, len(stack.Elements))
+		if block.Min.X <= at.X && at.X < block.Max.X {
+			return hitTestElements(plot, []Element{el}, block.Inset(stack.Margin), at)
+		}
+	}
+	return Hit{}, false
+}
+
+// Layout updates the margin such that the elements fit into bounds.
+func (stack *HStack) Layout(plot *Plot, measurer TextMeasurer, bounds Rect) {
+	stack.Margin = layoutMargin(plot, measurer, stack.Padding, stack.Elements, func(i int) Rect {
+		return bounds.Column(i, len(stack.Elements))
+	})
+}
+
 // HFlex implements horizontally stacked elements with non-equal sizes.
 type HFlex struct {
 	Margin Rect
+	// Padding is the space around each element in addition to the overflow, see Layout.
+	Padding Rect
 
 	fixedSize []float64
 	elements  Elements
@@ -112,6 +196,36 @@ func (stack *HFlex) AddGroup(fixedSize float64, adds ...Element) {
 
 // Draw draws elements.
 func (stack *HFlex) Draw(plot *Plot, canvas Canvas) {
+	stack.layout(canvas.Bounds(), func(el Element, block Rect) {
+		el.Draw(plot, canvas.Context(block.Inset(stack.Margin)))
+	})
+}
+
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (stack *HFlex) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	var hit Hit
+	var found bool
+	stack.layout(bounds, func(el Element, block Rect) {
+		if block.Min.X <= at.X && at.X < block.Max.X {
+			hit, found = hitTestElements(plot, []Element{el}, block.Inset(stack.Margin), at)
+		}
+	})
+	return hit, found
+}
+
+// Layout updates the margin such that the elements fit into bounds.
+func (stack *HFlex) Layout(plot *Plot, measurer TextMeasurer, bounds Rect) {
+	var els []Element
+	var blocks []Rect
+	stack.layout(bounds, func(el Element, block Rect) {
+		els = append(els, el)
+		blocks = append(blocks, block)
+	})
+	stack.Margin = layoutMargin(plot, measurer, stack.Padding, els, func(i int) Rect { return blocks[i] })
+}
+
+// layout calculates the bounds for each element.
+func (stack *HFlex) layout(bounds Rect, fn func(el Element, block Rect)) {
 	if len(stack.elements) == 0 {
 		return
 	}
@@ -128,7 +242,6 @@ func (stack *HFlex) Draw(plot *Plot, canvas Canvas) {
 		}
 	}
 
-	bounds := canvas.Bounds()
 	size := bounds.Size()
 
 	flexWidth := (bounds.Size().X - fixedSize) / flexCount
@@ -149,13 +262,15 @@ func (stack *HFlex) Draw(plot *Plot, canvas Canvas) {
 		}
 		min.X = block.Max.X
 
-		el.Draw(plot, canvas.Context(block.Inset(stack.Margin)))
+		fn(el, block)
 	}
 }
 
 // VFlex implements horizontally stacked elements with non-equal sizes.
 type VFlex struct {
 	Margin Rect
+	// Padding is the space around each element in addition to the overflow, see Layout.
+	Padding Rect
 
 	fixedSize []float64
 	elements  Elements
@@ -180,6 +295,36 @@ func (stack *VFlex) AddGroup(fixedSize float64, adds ...Element) {
 
 // Draw draws elements.
 func (stack *VFlex) Draw(plot *Plot, canvas Canvas) {
+	stack.layout(canvas.Bounds(), func(el Element, block Rect) {
+		el.Draw(plot, canvas.Context(block.Inset(stack.Margin)))
+	})
+}
+
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (stack *VFlex) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	var hit Hit
+	var found bool
+	stack.layout(bounds, func(el Element, block Rect) {
+		if block.Min.Y <= at.Y && at.Y < block.Max.Y {
+			hit, found = hitTestElements(plot, []Element{el}, block.Inset(stack.Margin), at)
+		}
+	})
+	return hit, found
+}
+
+// Layout updates the margin such that the elements fit into bounds.
+func (stack *VFlex) Layout(plot *Plot, measurer TextMeasurer, bounds Rect) {
+	var els []Element
+	var blocks []Rect
+	stack.layout(bounds, func(el Element, block Rect) {
+		els = append(els, el)
+		blocks = append(blocks, block)
+	})
+	stack.Margin = layoutMargin(plot, measurer, stack.Padding, els, func(i int) Rect { return blocks[i] })
+}
+
+// layout calculates the bounds for each element.
+func (stack *VFlex) layout(bounds Rect, fn func(el Element, block Rect)) {
 	if len(stack.elements) == 0 {
 		return
 	}
@@ -196,7 +341,6 @@ func (stack *VFlex) Draw(plot *Plot, canvas Canvas) {
 		}
 	}
 
-	bounds := canvas.Bounds()
 	size := bounds.Size()
 
 	flexWidth := (bounds.Size().Y - fixedSize) / flexCount
@@ -217,6 +361,6 @@ func (stack *VFlex) Draw(plot *Plot, canvas Canvas) {
 		}
 		min.Y = block.Max.Y
 
-		el.Draw(plot, canvas.Context(block.Inset(stack.Margin)))
+		fn(el, block)
 	}
 }
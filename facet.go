@@ -0,0 +1,311 @@
+package plot
+
+import "math"
+
+// Facet implements a grid of panels, which can share their axes.
+type Facet struct {
+	// Rows and Columns determine the grid size,
+	// when zero, they are calculated from the number of panels.
+	Rows, Columns int
+	Panels        []*Panel
+
+	// ShareX, ShareY use the same axis for all panels,
+	// which is calculated from the combined stats of the panels.
+	ShareX, ShareY bool
+	// X, Y are the shared axes.
+	X, Y *Axis
+
+	// TickLabelsX, TickLabelsY are drawn for each panel, for shared axes
+	// only on the outer panels.
+	TickLabelsX *TickLabelsX
+	TickLabelsY *TickLabelsY
+
+	Margin Rect
+	// Padding is the space around each panel in addition to the overflow, see Layout.
+	Padding Rect
+
+	TitleStyle Style
+}
+
+// Panel is a single panel in a facet.
+type Panel struct {
+	// Title and Subtitle are drawn above the panel and Caption below it.
+	Title    string
+	Subtitle string
+	Caption  string
+	// X, Y are the axes of the panel, when the axes are not shared.
+	// When nil, the facet axes are used.
+	X, Y *Axis
+	Elements
+}
+
+// NewFacet creates a new facet with the specified grid size.
+func NewFacet(rows, columns int) *Facet {
+	x, y := NewAxis(), NewAxis()
+	y.Flip = true
+	return &Facet{
+		Rows:        rows,
+		Columns:     columns,
+		X:           x,
+		Y:           y,
+		TickLabelsX: NewTickLabelsX(),
+		TickLabelsY: NewTickLabelsY(),
+	}
+}
+
+// Add adds a new panel with the specified title.
+func (facet *Facet) Add(title string, els ...Element) *Panel {
+	x, y := NewAxis(), NewAxis()
+	y.Flip = true
+	panel := &Panel{
+		Title:    title,
+		X:        x,
+		Y:        y,
+		Elements: Elements(els),
+	}
+	facet.Panels = append(facet.Panels, panel)
+	return panel
+}
+
+// size returns the number of rows and columns.
+func (facet *Facet) size() (rows, columns int) {
+	rows, columns = facet.Rows, facet.Columns
+	n := len(facet.Panels)
+	if columns <= 0 {
+		if rows > 0 {
+			columns = (n + rows - 1) / rows
+		} else {
+			columns = int(math.Ceil(math.Sqrt(float64(n))))
+		}
+	}
+	if rows <= 0 && columns > 0 {
+		rows = (n + columns - 1) / columns
+	}
+	return rows, columns
+}
+
+// Stats calculates the combined stats of all panels.
+func (facet *Facet) Stats() Stats {
+	els := make([]Element, 0, len(facet.Panels))
+	for _, panel := range facet.Panels {
+		els = append(els, panel.Elements)
+	}
+	return maximalStats(els)
+}
+
+// axes returns the shared axes, which are also used for panels without axes.
+func (facet *Facet) axes() (x, y *Axis) {
+	x, y = facet.X, facet.Y
+	if x == nil {
+		x = NewAxis()
+	}
+	if y == nil {
+		y = NewAxis()
+		y.Flip = true
+	}
+	return x, y
+}
+
+// facetCell is a panel with resolved axes and decorations.
+type facetCell struct {
+	facet   *Facet
+	panel   *Panel
+	plot    *Plot
+	labelsX bool
+	labelsY bool
+}
+
+// cells calculates the axes and decorations for each panel.
+func (facet *Facet) cells(plot *Plot) []Element {
+	rows, columns := facet.size()
+	if rows == 0 || columns == 0 {
+		return nil
+	}
+
+	facetX, facetY := facet.axes()
+	var sharedX, sharedY *Axis
+	if facet.ShareX || facet.ShareY {
+		sharedX, sharedY = detectAxis(facetX, facetY, []Element{facet})
+	}
+
+	cells := make([]Element, 0, len(facet.Panels))
+	for i, panel := range facet.Panels {
+		if i >= rows*columns {
+			break
+		}
+		row, column := i/columns, i%columns
+
+		tmpplot := &Plot{}
+		*tmpplot = *plot
+		panelX, panelY := panel.X, panel.Y
+		if panelX == nil {
+			panelX = facetX
+		}
+		if panelY == nil {
+			panelY = facetY
+		}
+		tmpplot.X, tmpplot.Y = detectAxis(panelX, panelY, panel.Elements)
+		if facet.ShareX {
+			tmpplot.X = sharedX
+		}
+		if facet.ShareY {
+			tmpplot.Y = sharedY
+		}
+
+		// the panel is in the bottom row, when there's no panel below it
+		bottom := row == rows-1 || i+columns >= len(facet.Panels)
+		cells = append(cells, &facetCell{
+			facet:   facet,
+			panel:   panel,
+			plot:    tmpplot,
+			labelsX: !facet.ShareX || bottom,
+			labelsY: !facet.ShareY || column == 0,
+		})
+	}
+	return cells
+}
+
+// block returns the bounds for i-th panel.
+func (facet *Facet) block(bounds Rect, i int) Rect {
+	rows, columns := facet.size()
+	return bounds.Row(i/columns, rows).Column(i%columns, columns)
+}
+
+// margin returns the margin around each panel. When the margin hasn't been
+// set, e.g. by Layout, only the space for the panel headings is reserved.
+func (facet *Facet) margin(measurer TextMeasurer, cells []Element) Rect {
+	if !facet.Margin.Empty() {
+		return facet.Margin
+	}
+
+	var insets Rect
+	for _, cell := range cells {
+		cell := cell.(*facetCell)
+		if header := cell.header(); len(header) > 0 {
+			insets.Min.Y = math.Max(insets.Min.Y, titleHeight(measurer, header))
+		}
+		if footer := cell.footer(); len(footer) > 0 {
+			// the caption is below the tick labels, see captionTop
+			bottom := cell.captionTop(measurer, Rect{}) + titleHeight(measurer, footer)
+			insets.Max.Y = math.Max(insets.Max.Y, bottom)
+		}
+	}
+	if insets.Min.Y > 0 {
+		insets.Min.Y += facet.Padding.Min.Y
+	}
+	if insets.Max.Y > 0 {
+		insets.Max.Y += facet.Padding.Max.Y
+	}
+	return insets
+}
+
+// Draw draws the panels to canvas.
+func (facet *Facet) Draw(plot *Plot, canvas Canvas) {
+	bounds := canvas.Bounds()
+	cells := facet.cells(plot)
+	margin := facet.margin(textMeasurer(canvas), cells)
+	for i, cell := range cells {
+		cell.Draw(plot, canvas.Context(facet.block(bounds, i).Inset(margin)))
+	}
+}
+
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (facet *Facet) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	cells := facet.cells(plot)
+	// the canvas isn't available, so the headings are approximated
+	margin := facet.margin(ApproxTextMeasurer{}, cells)
+	for i, cell := range cells {
+		block := facet.block(bounds, i)
+		if block.Min.X <= at.X && at.X < block.Max.X && block.Min.Y <= at.Y && at.Y < block.Max.Y {
+			cell := cell.(*facetCell)
+			return cell.panel.Elements.HitTest(cell.plot, block.Inset(margin), at)
+		}
+	}
+	return Hit{}, false
+}
+
+// Layout updates the margin such that the panels fit into bounds.
+func (facet *Facet) Layout(plot *Plot, measurer TextMeasurer, bounds Rect) {
+	facet.Margin = layoutMargin(plot, measurer, facet.Padding, facet.cells(plot), func(i int) Rect {
+		return facet.block(bounds, i)
+	})
+}
+
+// titleStyle returns the style for panel titles.
+func (facet *Facet) titleStyle(plot *Plot) Style {
+	if facet.TitleStyle.IsZero() {
+		return plot.Theme.Font
+	}
+	return facet.TitleStyle
+}
+
+// header returns the title and subtitle lines of the panel.
+func (cell *facetCell) header() []titleLine {
+	return titleLines(
+		titleLine{cell.panel.Title, cell.facet.titleStyle(cell.plot)},
+		titleLine{cell.panel.Subtitle, cell.plot.Theme.Subtitle},
+	)
+}
+
+// footer returns the caption lines of the panel.
+func (cell *facetCell) footer() []titleLine {
+	return titleLines(
+		titleLine{cell.panel.Caption, cell.plot.Theme.Caption},
+	)
+}
+
+// captionTop returns the position of the caption below the panel and its tick labels.
+func (cell *facetCell) captionTop(measurer TextMeasurer, bounds Rect) Length {
+	top := bounds.Max.Y + defaultLabelSpacing
+	if cell.labelsX && cell.facet.TickLabelsX != nil {
+		top += cell.facet.TickLabelsX.Overflow(cell.plot, measurer, bounds).Max.Y
+	}
+	return top
+}
+
+// Draw draws the panel using the resolved axes.
+func (cell *facetCell) Draw(_ *Plot, canvas Canvas) {
+	plot := cell.plot
+	cell.panel.Elements.Draw(plot, canvas)
+
+	if cell.labelsX && cell.facet.TickLabelsX != nil {
+		cell.facet.TickLabelsX.Draw(plot, canvas)
+	}
+	if cell.labelsY && cell.facet.TickLabelsY != nil {
+		cell.facet.TickLabelsY.Draw(plot, canvas)
+	}
+
+	header, footer := cell.header(), cell.footer()
+	if len(header) == 0 && len(footer) == 0 {
+		return
+	}
+
+	measurer := textMeasurer(canvas)
+	bounds := canvas.Bounds()
+	center := (bounds.Min.X + bounds.Max.X) * 0.5
+	drawTitle(canvas, measurer, header, center, bounds.Min.Y-titleHeight(measurer, header))
+	drawTitle(canvas, measurer, footer, center, cell.captionTop(measurer, bounds))
+}
+
+// Overflow calculates how much the panel extends beyond bounds on each side.
+func (cell *facetCell) Overflow(_ *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	plot := cell.plot
+	overflow := elementOverflow(plot, measurer, cell.panel.Elements, bounds)
+
+	if cell.labelsX && cell.facet.TickLabelsX != nil {
+		overflow = maxInsets(overflow, cell.facet.TickLabelsX.Overflow(plot, measurer, bounds))
+	}
+	if cell.labelsY && cell.facet.TickLabelsY != nil {
+		overflow = maxInsets(overflow, cell.facet.TickLabelsY.Overflow(plot, measurer, bounds))
+	}
+
+	if header := cell.header(); len(header) > 0 {
+		overflow.Min.Y = math.Max(overflow.Min.Y, titleHeight(measurer, header))
+	}
+	if footer := cell.footer(); len(footer) > 0 {
+		bottom := cell.captionTop(measurer, bounds) + titleHeight(measurer, footer)
+		overflow.Max.Y = math.Max(overflow.Max.Y, bottom-bounds.Max.Y)
+	}
+
+	return overflow
+}
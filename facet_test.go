@@ -0,0 +1,72 @@
+package plot
+
+import "testing"
+
+func TestFacetNilAxes(t *testing.T) {
+	facet := &Facet{Columns: 2}
+	facet.Panels = append(facet.Panels,
+		&Panel{Elements: Elements{NewLine("", []Point{{0, 0}, {10, 5}})}},
+		&Panel{Elements: Elements{NewLine("", []Point{{0, 0}, {2, 50}})}},
+	)
+
+	for _, share := range []bool{false, true} {
+		facet.ShareX, facet.ShareY = share, share
+		for i, cell := range facet.cells(New()) {
+			plot := cell.(*facetCell).plot
+			if !plot.X.IsValid() || !plot.Y.IsValid() {
+				t.Errorf("share %v: panel %d: invalid axes", share, i)
+			}
+		}
+	}
+}
+
+func TestFacetMarginWithoutLayout(t *testing.T) {
+	measurer := ApproxTextMeasurer{}
+	title := titleHeight(measurer, titleLines(titleLine{"Title", New().Theme.Font}))
+
+	tests := []struct {
+		name    string
+		title   string
+		caption string
+		padding Rect
+		margin  Rect
+		top     Length
+	}{
+		{"without titles", "", "", R(5, 5, 5, 5), Rect{}, 0},
+		{"title", "Title", "", Rect{}, Rect{}, title},
+		{"title with padding", "Title", "", R(5, 5, 5, 5), Rect{}, title + 5},
+		{"caption", "", "Caption", Rect{}, Rect{}, 0},
+		{"layout", "Title", "Caption", Rect{}, R(1, 2, 3, 4), 2},
+	}
+
+	for _, test := range tests {
+		facet := NewFacet(1, 2)
+		facet.Padding = test.padding
+		facet.Margin = test.margin
+		facet.Add(test.title, NewLine("", []Point{{0, 0}, {1, 1}})).Caption = test.caption
+		facet.Add("", NewLine("", []Point{{0, 0}, {1, 1}}))
+
+		cells := facet.cells(New())
+		margin := facet.margin(measurer, cells)
+		if margin.Min.Y != test.top {
+			t.Errorf("%s: got margin %v, expected top %v", test.name, margin, test.top)
+		}
+
+		switch {
+		case !test.margin.Empty():
+			if margin != test.margin {
+				t.Errorf("%s: got margin %v, expected %v", test.name, margin, test.margin)
+			}
+		case test.caption != "":
+			cell := cells[0].(*facetCell)
+			bottom := cell.captionTop(measurer, Rect{}) + titleHeight(measurer, cell.footer())
+			if margin.Max.Y < bottom {
+				t.Errorf("%s: caption overlaps the next panel: got margin %v, caption bottom %v", test.name, margin, bottom)
+			}
+		default:
+			if margin.Max.Y != 0 {
+				t.Errorf("%s: got margin %v, expected no bottom margin", test.name, margin)
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package plot
+
+// GapMode determines how missing (NaN or infinite) values are drawn in lines.
+type GapMode byte
+
+const (
+	// GapBreak splits the line into separate polylines at missing values.
+	GapBreak GapMode = iota
+	// GapSkip ignores missing values and draws the line directly
+	// between the surrounding values.
+	GapSkip
+	// GapConnect splits the line at missing values and connects
+	// the separate polylines with a dashed line.
+	GapConnect
+)
+
+// splitGaps splits points into polylines at non-finite points.
+func splitGaps(points []Point) [][]Point {
+	var polylines [][]Point
+	start := -1
+	for i, p := range points {
+		if isFinitePoint(p) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			polylines = append(polylines, points[start:i])
+			start = -1
+		}
+	}
+	if start >= 0 {
+		polylines = append(polylines, points[start:])
+	}
+	return polylines
+}
+
+// drawPolyline draws canvas space points handling missing values.
+// When simplify is not nil, then it's applied to each polyline separately.
+// Each polyline is drawn using the interpolation and gaps are connected using gap.
+func (mode GapMode) drawPolyline(canvas Canvas, points []Point, style, gap *Style, simplify func([]Point) []Point, interpolation Interpolation) {
+	polylines := splitGaps(points)
+	if len(polylines) == 0 {
+		return
+	}
+
+	if mode == GapSkip && len(polylines) > 1 {
+		joined := make([]Point, 0, len(points))
+		for _, polyline := range polylines {
+			joined = append(joined, polyline...)
+		}
+		polylines = [][]Point{joined}
+	}
+
+	for _, polyline := range polylines {
+		if simplify != nil {
+			polyline = simplify(polyline)
+		}
+		interpolation.draw(canvas, polyline, style)
+	}
+
+	if mode == GapConnect && len(polylines) > 1 {
+		bridge := *style
+		bridge.Fill = nil
+		bridge.FillGradient = nil
+		if gap.Stroke != nil {
+			bridge.Stroke = gap.Stroke
+		}
+		if gap.Size != 0 {
+			bridge.Size = gap.Size
+		}
+		bridge.Dash = gap.Dash
+		bridge.DashOffset = gap.DashOffset
+		for i := 1; i < len(polylines); i++ {
+			prev, next := polylines[i-1], polylines[i]
+			canvas.Poly([]Point{prev[len(prev)-1], next[0]}, &bridge)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGapConnectStyle(t *testing.T) {
+	p := New()
+	p.X.Min, p.X.Max = 0, 3
+	p.Y.Min, p.Y.Max = 0, 3
+	p.Theme.Gap.Dash = []Length{5, 1}
+
+	canvas := &styleCanvas{polyCanvas: polyCanvas{bounds: R(0, 0, 100, 100)}}
+	line := NewLine("", []Point{{0, 0}, {1, 1}, {2, math.NaN()}, {3, 3}})
+	line.Gaps = GapConnect
+	line.Draw(p, canvas)
+
+	styles := canvas.styles
+	if len(styles) != 3 {
+		t.Fatalf("got %d polylines, expected 3", len(styles))
+	}
+	bridge := styles[2]
+	if len(bridge.Dash) != 2 || bridge.Dash[0] != 5 || bridge.Dash[1] != 1 {
+		t.Errorf("got bridge dash %v, expected the theme gap dash", bridge.Dash)
+	}
+	if bridge.Stroke != styles[0].Stroke || bridge.Size != styles[0].Size {
+		t.Errorf("got bridge %v, expected the line stroke %v", bridge, styles[0])
+	}
+}
+
+func TestAreaSplitsAtGaps(t *testing.T) {
+	p := New()
+	p.X.Min, p.X.Max = 0, 5
+	p.Y.Min, p.Y.Max = 0, 5
+
+	canvas := &polyCanvas{bounds: R(0, 0, 100, 100)}
+	area := NewArea("", []Point{{0, 1}, {1, 2}, {2, math.NaN()}, {3, 2}, {4, 1}, {5, 3}})
+	area.Draw(p, canvas)
+
+	// each part is filled and stroked separately
+	if len(canvas.polys) != 4 {
+		t.Fatalf("got %d polygons, expected 4", len(canvas.polys))
+	}
+	for _, poly := range canvas.polys {
+		for _, q := range poly {
+			if x := p.X.FromCanvas(q.X, 0, 100); x > 1+1e-9 && x < 3-1e-9 {
+				t.Errorf("polygon %v crosses the gap", poly)
+			}
+		}
+	}
+}
+
+func TestPointsBetween(t *testing.T) {
+	points := []Point{{0, 0}, {2, 2}, {4, 0}, {6, 2}}
+	tests := []struct {
+		x0, x1   float64
+		expected []Point
+	}{
+		{1, 5, []Point{{1, 1}, {2, 2}, {4, 0}, {5, 1}}},
+		{2, 4, []Point{{2, 2}, {4, 0}}},
+		{-1, 0.5, []Point{{-1, 0}, {0, 0}, {0.5, 0.5}}},
+		{5, 8, []Point{{5, 1}, {6, 2}, {8, 2}}},
+	}
+
+	for _, test := range tests {
+		got := pointsBetween(points, test.x0, test.x1)
+		if !equalPoints(got, test.expected) {
+			t.Errorf("%v..%v: got %v, expected %v", test.x0, test.x1, got, test.expected)
+		}
+	}
+}
+
+// styleCanvas records the style of every drawn polyline.
+type styleCanvas struct {
+	polyCanvas
+	styles []Style
+}
+
+func (canvas *styleCanvas) Layer(index int) Canvas { return canvas }
+func (canvas *styleCanvas) Clip(r Rect) Canvas     { return canvas }
+func (canvas *styleCanvas) Context(r Rect) Canvas  { return canvas }
+
+func (canvas *styleCanvas) Poly(points []Point, style *Style) {
+	canvas.styles = append(canvas.styles, *style)
+	canvas.polyCanvas.Poly(points, style)
+}
+
+func TestSplitGaps(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	tests := []struct {
+		name     string
+		points   []Point
+		expected [][]Point
+	}{
+		{"empty", nil, nil},
+		{"finite", []Point{{0, 0}, {1, 1}}, [][]Point{{{0, 0}, {1, 1}}}},
+		{"missing", []Point{{0, nan}, {nan, 1}, {2, inf}}, nil},
+		{"middle", []Point{{0, 0}, {1, nan}, {2, 2}, {3, 3}}, [][]Point{{{0, 0}}, {{2, 2}, {3, 3}}}},
+		{"ends", []Point{{0, nan}, {1, 1}, {2, 2}, {inf, 3}}, [][]Point{{{1, 1}, {2, 2}}}},
+		{"consecutive", []Point{{0, 0}, {1, nan}, {nan, nan}, {3, 3}}, [][]Point{{{0, 0}}, {{3, 3}}}},
+	}
+
+	for _, test := range tests {
+		got := splitGaps(test.points)
+		if len(got) != len(test.expected) {
+			t.Errorf("%s: got %v, expected %v", test.name, got, test.expected)
+			continue
+		}
+		for i := range got {
+			if !equalPoints(got[i], test.expected[i]) {
+				t.Errorf("%s: got %v, expected %v", test.name, got, test.expected)
+				break
+			}
+		}
+	}
+}
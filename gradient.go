@@ -0,0 +1,138 @@
+package plot
+
+import (
+	"image/color"
+	"math"
+)
+
+// GradientUnits determines the coordinate space of gradient positions.
+type GradientUnits byte
+
+const (
+	// GradientRelative positions are relative to the bounding box of the shape,
+	// where (0, 0) is the top-left and (1, 1) the bottom-right corner.
+	GradientRelative GradientUnits = iota
+	// GradientCanvas positions are in the canvas space of the context.
+	GradientCanvas
+	// GradientData positions are in data space, which the elements
+	// convert to canvas space using the plot axes.
+	GradientData
+)
+
+// GradientStop is a color at the specified offset along the gradient, 0..1.
+type GradientStop struct {
+	Offset float64
+	Color  color.Color
+}
+
+// Gradient describes a linear or radial color gradient.
+type Gradient struct {
+	Radial bool
+	Units  GradientUnits
+
+	// Start and End define the gradient line. For radial gradients
+	// Start is the center and End is a point on the outer circle.
+	Start, End Point
+	// Stops are sorted by offset.
+	Stops []GradientStop
+}
+
+// NewLinearGradient creates a gradient from start to end in relative units.
+func NewLinearGradient(start, end Point, stops ...GradientStop) *Gradient {
+	return &Gradient{
+		Start: start,
+		End:   end,
+		Stops: stops,
+	}
+}
+
+// NewRadialGradient creates a gradient around center in relative units.
+func NewRadialGradient(center Point, radius Length, stops ...GradientStop) *Gradient {
+	return &Gradient{
+		Radial: true,
+		Start:  center,
+		End:    center.Add(Point{radius, 0}),
+		Stops:  stops,
+	}
+}
+
+// Radius returns the radius of a radial gradient.
+func (gradient *Gradient) Radius() Length {
+	d := gradient.End.Sub(gradient.Start)
+	return math.Hypot(d.X, d.Y)
+}
+
+// At returns the color at offset t.
+func (gradient *Gradient) At(t float64) color.Color {
+	stops := gradient.Stops
+	if len(stops) == 0 {
+		return nil
+	}
+	if t <= stops[0].Offset {
+		return stops[0].Color
+	}
+	for i := 1; i < len(stops); i++ {
+		a, b := stops[i-1], stops[i]
+		if t <= b.Offset {
+			if b.Offset <= a.Offset {
+				return b.Color
+			}
+			return InterpolateColor((t-a.Offset)/(b.Offset-a.Offset), a.Color, b.Color)
+		}
+	}
+	return stops[len(stops)-1].Color
+}
+
+// Canvas converts the gradient to canvas units, where bounds is
+// the bounding box of the shape used for relative units.
+func (gradient *Gradient) Canvas(bounds Rect) *Gradient {
+	if gradient.Units != GradientRelative {
+		return gradient
+	}
+	result := *gradient
+	result.Units = GradientCanvas
+	result.Start = bounds.UnitLocation(gradient.Start.Scale(2).Sub(Point{1, 1}))
+	result.End = bounds.UnitLocation(gradient.End.Scale(2).Sub(Point{1, 1}))
+	return &result
+}
+
+// project converts data units to canvas units using the coordinate mode of plot.
+func (gradient *Gradient) project(plot *Plot, bounds Rect) *Gradient {
+	if gradient.Units != GradientData {
+		return gradient
+	}
+	size := bounds.Size()
+	result := *gradient
+	result.Units = GradientCanvas
+	result.Start = plot.toCanvas(gradient.Start, size)
+	result.End = plot.toCanvas(gradient.End, size)
+	return &result
+}
+
+// HasFill checks whether the style fills shapes with a color or gradient.
+func (style *Style) HasFill() bool {
+	return style.Fill != nil || style.FillGradient != nil
+}
+
+// resolveStyle converts data space gradients in style to canvas space.
+func (plot *Plot) resolveStyle(style *Style, bounds Rect) *Style {
+	if style.FillGradient == nil || style.FillGradient.Units != GradientData {
+		return style
+	}
+	resolved := *style
+	resolved.FillGradient = style.FillGradient.project(plot, bounds)
+	return &resolved
+}
+
+// PointsBounds calculates the bounding box of points.
+func PointsBounds(points []Point) Rect {
+	if len(points) == 0 {
+		return Rect{}
+	}
+	r := Rect{points[0], points[0]}
+	for _, p := range points[1:] {
+		r.Min = r.Min.Min(p)
+		r.Max = r.Max.Max(p)
+	}
+	return r
+}
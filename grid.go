@@ -1,7 +1,5 @@
 package plot
 
-import "image/color"
-
 // Grid implements faceted background.
 type Grid struct {
 	GridTheme
@@ -65,6 +63,7 @@ func (grid *Grid) Draw(plot *Plot, canvas Canvas) {
 // Gizmo implements drawing X and Y axis.
 type Gizmo struct {
 	Center Point
+	GizmoTheme
 }
 
 // NewGizmo creates a new gizmo element.
@@ -82,15 +81,20 @@ func (gizmo *Gizmo) Draw(plot *Plot, canvas Canvas) {
 	x0, xmin, xmax := x.ToCanvas(gizmo.Center.X, 0, size.X), 0.0, size.X
 	y0, ymin, ymax := y.ToCanvas(gizmo.Center.Y, 0, size.Y), 0.0, size.Y
 
+	theme := &gizmo.GizmoTheme
+	if theme.IsZero() {
+		theme = &plot.Theme.Gizmo
+	}
+
 	if xmin < x0 && x0 < xmax {
 		canvas.Poly(Ps(x0, ymin, x0, ymax), &Style{
-			Stroke: color.NRGBA{30, 0, 0, 100},
+			Stroke: theme.X,
 		})
 	}
 
 	if ymin < y0 && y0 < ymax {
 		canvas.Poly(Ps(xmin, y0, xmax, y0), &Style{
-			Stroke: color.NRGBA{0, 30, 0, 100},
+			Stroke: theme.Y,
 		})
 	}
 }
@@ -0,0 +1,255 @@
+package plot
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sort"
+)
+
+// Heatmap implements a 2D matrix of colored cells.
+type Heatmap struct {
+	Style
+	Label string
+
+	// X, Y are the cell edges, len(X) = columns + 1 and len(Y) = rows + 1.
+	// When nil or of a different length, the cells are placed at integer coordinates.
+	X, Y []float64
+	// Values are the cell values indexed as Values[row][column].
+	Values [][]float64
+
+	// Scale maps values to colors.
+	Scale *ColorScale
+
+	// Raster draws the cells as a single image, which is much faster
+	// for large matrices. The edges are assumed to be evenly spaced.
+	// Canvases that don't implement ImageCanvas draw the cells instead.
+	Raster bool
+}
+
+// NewHeatmap creates a heatmap from values with the specified edges.
+func NewHeatmap(label string, x, y []float64, values [][]float64) *Heatmap {
+	return &Heatmap{
+		Label:  label,
+		X:      x,
+		Y:      y,
+		Values: values,
+		Scale:  NewColorScale(),
+	}
+}
+
+// size returns the number of rows and columns.
+func (heatmap *Heatmap) size() (rows, columns int) {
+	rows = len(heatmap.Values)
+	for _, row := range heatmap.Values {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	return rows, columns
+}
+
+// edges returns the cell edges, ignoring the ones that don't match the size.
+func (heatmap *Heatmap) edges(rows, columns int) (xs, ys []float64) {
+	xs, ys = heatmap.X, heatmap.Y
+	if len(xs) != columns+1 {
+		xs = nil
+	}
+	if len(ys) != rows+1 {
+		ys = nil
+	}
+	return xs, ys
+}
+
+// edge returns the i-th edge, defaulting to integer coordinates.
+func edge(edges []float64, i int) float64 {
+	if edges == nil {
+		return float64(i)
+	}
+	return edges[i]
+}
+
+// ColorScale returns the scale with automatically detected range.
+func (heatmap *Heatmap) ColorScale() *ColorScale {
+	scale := NewColorScale()
+	if heatmap.Scale != nil {
+		*scale = *heatmap.Scale
+	}
+	if !math.IsNaN(scale.Min) && !math.IsNaN(scale.Max) {
+		return scale
+	}
+
+	detected := &ColorScale{Min: math.NaN(), Max: math.NaN(), Log: scale.Log}
+	for _, row := range heatmap.Values {
+		for _, v := range row {
+			if isFinite(v) {
+				detected.Include(v, v)
+			}
+		}
+	}
+
+	if math.IsNaN(scale.Min) {
+		scale.Min = detected.Min
+	}
+	if math.IsNaN(scale.Max) {
+		scale.Max = detected.Max
+	}
+	return scale
+}
+
+// Stats calculates element statistics.
+func (heatmap *Heatmap) Stats() Stats {
+	rows, columns := heatmap.size()
+	if rows == 0 || columns == 0 {
+		return nanStats
+	}
+
+	xs, ys := heatmap.edges(rows, columns)
+	x0, x1 := edge(xs, 0), edge(xs, columns)
+	y0, y1 := edge(ys, 0), edge(ys, rows)
+	stats := Stats{
+		Min: Point{math.Min(x0, x1), math.Min(y0, y1)},
+		Max: Point{math.Max(x0, x1), math.Max(y0, y1)},
+	}
+	stats.Center = stats.Min.Add(stats.Max).Scale(0.5)
+	return stats
+}
+
+// Draw draws the element to canvas.
+func (heatmap *Heatmap) Draw(plot *Plot, canvas Canvas) {
+	canvas = canvas.Clip(canvas.Bounds())
+	size := canvas.Bounds().Size()
+
+	scale := heatmap.ColorScale()
+	if math.IsNaN(scale.Min) || math.IsNaN(scale.Max) {
+		return
+	}
+
+	rows, columns := heatmap.size()
+	if _, ok := canvas.(ImageCanvas); ok && heatmap.Raster {
+		heatmap.drawRaster(plot, canvas, scale, rows, columns)
+		return
+	}
+
+	xedges, yedges := heatmap.edges(rows, columns)
+	xs := make([]Length, columns+1)
+	for i := range xs {
+		xs[i] = plot.X.ToCanvas(edge(xedges, i), 0, size.X)
+	}
+
+	for row, values := range heatmap.Values {
+		if row >= rows {
+			break
+		}
+		y0 := plot.Y.ToCanvas(edge(yedges, row), 0, size.Y)
+		y1 := plot.Y.ToCanvas(edge(yedges, row+1), 0, size.Y)
+		for column, v := range values {
+			if !isFinite(v) {
+				continue
+			}
+			style := heatmap.Style
+			style.Fill = scale.Color(v)
+			canvas.Rect(R(xs[column], y0, xs[column+1], y1), &style)
+		}
+	}
+}
+
+// drawRaster draws the cells as an image.
+func (heatmap *Heatmap) drawRaster(plot *Plot, canvas Canvas, scale *ColorScale, rows, columns int) {
+	if rows == 0 || columns == 0 {
+		return
+	}
+	size := canvas.Bounds().Size()
+
+	xs, ys := heatmap.edges(rows, columns)
+	x0 := plot.X.ToCanvas(edge(xs, 0), 0, size.X)
+	x1 := plot.X.ToCanvas(edge(xs, columns), 0, size.X)
+	y0 := plot.Y.ToCanvas(edge(ys, 0), 0, size.Y)
+	y1 := plot.Y.ToCanvas(edge(ys, rows), 0, size.Y)
+
+	img := image.NewNRGBA(image.Rect(0, 0, columns, rows))
+	for row, values := range heatmap.Values {
+		y := row
+		if y0 > y1 {
+			y = rows - 1 - row
+		}
+		for column, v := range values {
+			if !isFinite(v) {
+				continue
+			}
+			x := column
+			if x0 > x1 {
+				x = columns - 1 - column
+			}
+			img.SetNRGBA(x, y, color.NRGBAModel.Convert(scale.Color(v)).(color.NRGBA))
+		}
+	}
+
+	DrawImage(canvas, img, canonicalRect(x0, y0, x1, y1), &heatmap.Style)
+}
+
+// HitTest finds the cell under at, when the element is drawn to bounds.
+func (heatmap *Heatmap) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	if math.IsNaN(at.X) || math.IsNaN(at.Y) {
+		return Hit{}, false
+	}
+
+	size := bounds.Size()
+	local := at.Sub(bounds.Min)
+	x := plot.X.FromCanvas(local.X, 0, size.X)
+	y := plot.Y.FromCanvas(local.Y, 0, size.Y)
+
+	rows, columns := heatmap.size()
+	xs, ys := heatmap.edges(rows, columns)
+	column, ok := findCell(xs, columns, x)
+	if !ok {
+		return Hit{}, false
+	}
+	row, ok := findCell(ys, rows, y)
+	if !ok || column >= len(heatmap.Values[row]) {
+		return Hit{}, false
+	}
+
+	v := heatmap.Values[row][column]
+	if !isFinite(v) {
+		return Hit{}, false
+	}
+
+	center := Point{
+		X: (edge(xs, column) + edge(xs, column+1)) * 0.5,
+		Y: (edge(ys, row) + edge(ys, row+1)) * 0.5,
+	}
+
+	label := fmt.Sprintf("%.4g", v)
+	if heatmap.Label != "" {
+		label = heatmap.Label + ": " + label
+	}
+
+	return Hit{
+		Element: heatmap,
+		Label:   label,
+		Index:   row*columns + column,
+		Value:   center,
+		Position: Point{
+			X: plot.X.ToCanvas(center.X, 0, size.X),
+			Y: plot.Y.ToCanvas(center.Y, 0, size.Y),
+		}.Add(bounds.Min),
+		Distance: 0,
+	}, true
+}
+
+// findCell finds the cell index containing v.
+func findCell(edges []float64, count int, v float64) (int, bool) {
+	if count == 0 {
+		return 0, false
+	}
+	first, last := edge(edges, 0), edge(edges, count)
+	if first > last {
+		// descending edges
+		i := sort.Search(count+1, func(i int) bool { return edge(edges, i) < v })
+		return i - 1, i > 0 && i <= count
+	}
+	i := sort.Search(count+1, func(i int) bool { return edge(edges, i) > v })
+	return i - 1, i > 0 && i <= count
+}
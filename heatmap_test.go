@@ -0,0 +1,38 @@
+package plot
+
+import "testing"
+
+func TestHeatmapMismatchedEdges(t *testing.T) {
+	values := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	tests := []struct {
+		name string
+		x, y []float64
+	}{
+		{"nil", nil, nil},
+		{"short", []float64{0, 1}, []float64{0}},
+		{"long", []float64{0, 1, 2, 3, 4, 5}, []float64{0, 1, 2, 3}},
+	}
+
+	for _, test := range tests {
+		heatmap := NewHeatmap("", test.x, test.y, values)
+
+		stats := heatmap.Stats()
+		if stats.Min != (Point{0, 0}) || stats.Max != (Point{3, 2}) {
+			t.Errorf("%s: got stats %v, expected uniform cells", test.name, stats)
+		}
+
+		p := New()
+		p.X.Min, p.X.Max = 0, 3
+		p.Y.Min, p.Y.Max = 0, 2
+		canvas := &polyCanvas{bounds: R(0, 0, 300, 200)}
+		heatmap.Draw(p, canvas)
+		if len(canvas.rects) != 6 {
+			t.Errorf("%s: got %d cells, expected 6", test.name, len(canvas.rects))
+		}
+
+		hit, ok := heatmap.HitTest(p, canvas.bounds, Point{250, 150})
+		if !ok || hit.Value != (Point{2.5, 0.5}) {
+			t.Errorf("%s: got hit %v %v, expected the last cell of the first row", test.name, hit, ok)
+		}
+	}
+}
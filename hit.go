@@ -0,0 +1,150 @@
+package plot
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// HitTester is an Element that can find the nearest data item for a canvas position.
+type HitTester interface {
+	// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+	// When at.X or at.Y is NaN, then only the other coordinate is used for the distance.
+	HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool)
+}
+
+// Hit describes a data item found by hit testing.
+type Hit struct {
+	Element Element
+	Label   string
+	Index   int
+
+	// Value is the data item in value space.
+	Value Point
+	// Position is the data item in canvas space.
+	Position Point
+	// Distance is the canvas space distance from the tested position.
+	Distance Length
+}
+
+// Text returns lines describing the hit for tooltips.
+func (hit *Hit) Text() []string {
+	var lines []string
+	if hit.Label != "" {
+		lines = append(lines, hit.Label)
+	}
+	lines = append(lines,
+		fmt.Sprintf("X: %.4g", hit.Value.X),
+		fmt.Sprintf("Y: %.4g", hit.Value.Y),
+	)
+	return lines
+}
+
+// hitTestElements finds the nearest data item from elements.
+func hitTestElements(plot *Plot, els []Element, bounds Rect, at Point) (Hit, bool) {
+	best, found := Hit{Distance: math.Inf(1)}, false
+	for _, el := range els {
+		tester, ok := el.(HitTester)
+		if !ok {
+			continue
+		}
+		hit, ok := tester.HitTest(plot, bounds, at)
+		if ok && hit.Distance < best.Distance {
+			best, found = hit, true
+		}
+	}
+	return best, found
+}
+
+// hitDistance calculates distance between a and b ignoring NaN coordinates.
+func hitDistance(a, b Point) Length {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	if math.IsNaN(dx) && math.IsNaN(dy) {
+		return math.Inf(1)
+	}
+	if math.IsNaN(dx) {
+		return math.Abs(dy)
+	}
+	if math.IsNaN(dy) {
+		return math.Abs(dx)
+	}
+	return math.Hypot(dx, dy)
+}
+
+// sortedCache remembers whether data is sorted by X, such that
+// hit testing doesn't need to check it on every call.
+//
+// The result is recomputed when the slice is replaced or resized,
+// data modified in place needs to be reassigned with a new slice.
+type sortedCache struct {
+	first  *Point
+	length int
+	sorted bool
+}
+
+// check returns whether data is sorted by X.
+func (cache *sortedCache) check(data []Point) bool {
+	if len(data) == 0 {
+		return true
+	}
+	if cache.first != &data[0] || cache.length != len(data) {
+		cache.first, cache.length = &data[0], len(data)
+		cache.sorted = sort.SliceIsSorted(data, func(i, k int) bool { return data[i].X < data[k].X })
+	}
+	return cache.sorted
+}
+
+// hitTestPoints finds the nearest point to at.
+//
+// When data is sorted by X, it uses the inverse transform to find
+// the starting point for the search. When sorted is nil, data is
+// assumed to be sorted.
+func hitTestPoints(el Element, label string, data []Point, sorted *sortedCache, plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	if len(data) == 0 {
+		return Hit{}, false
+	}
+
+	size := bounds.Size()
+	local := at.Sub(bounds.Min)
+
+	best := Hit{
+		Element:  el,
+		Label:    label,
+		Index:    -1,
+		Distance: math.Inf(1),
+	}
+	check := func(i int) Length {
+		p := data[i]
+		screen := plot.toCanvas(p, size)
+		if distance := hitDistance(screen, local); distance < best.Distance {
+			best.Index = i
+			best.Value = p
+			best.Position = screen.Add(bounds.Min)
+			best.Distance = distance
+		}
+		return math.Abs(screen.X - local.X)
+	}
+
+	// polar coordinates don't preserve the X order in canvas space
+	linear := math.IsNaN(local.X) || plot.Polar != nil || (sorted != nil && !sorted.check(data))
+	if linear {
+		for i := range data {
+			check(i)
+		}
+	} else {
+		x := plot.X.FromCanvas(local.X, 0, size.X)
+		start := sort.Search(len(data), func(i int) bool { return data[i].X >= x })
+		for i := start; i < len(data); i++ {
+			if check(i) > best.Distance {
+				break
+			}
+		}
+		for i := start - 1; i >= 0; i-- {
+			if check(i) > best.Distance {
+				break
+			}
+		}
+	}
+
+	return best, best.Index >= 0
+}
@@ -0,0 +1,81 @@
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+// hitElement is an element that supports hit testing.
+type hitElement interface {
+	Element
+	HitTester
+}
+
+func TestHitTest(t *testing.T) {
+	nan := math.NaN()
+
+	line := NewLine("line", []Point{{0, 0}, {2, 4}, {4, 8}, {6, 4}, {8, 0}, {10, 5}})
+	// scattered points aren't sorted by X
+	scatter := NewLine("scatter", []Point{{8, 0}, {2, 4}, {10, 5}, {0, 0}, {6, 4}, {4, 8}})
+
+	stack := NewStackedArea([]float64{0, 5, 10})
+	stack.Add("a", []float64{2, 2, 2})
+	stack.Add("b", []float64{3, 3, 3})
+
+	tests := []struct {
+		name     string
+		element  hitElement
+		at       Point
+		label    string
+		index    int
+		value    Point
+		distance Length
+	}{
+		{"line point", line, Point{30, 80}, "line", 1, Point{2, 4}, 0},
+		{"line near", line, Point{51, 42}, "line", 2, Point{4, 8}, math.Sqrt(5)},
+		{"line column", line, Point{72, nan}, "line", 3, Point{6, 4}, 2},
+		{"line row", line, Point{nan, 41}, "line", 2, Point{4, 8}, 1},
+		{"scatter point", scatter, Point{30, 80}, "scatter", 1, Point{2, 4}, 0},
+		{"scatter near", scatter, Point{51, 42}, "scatter", 5, Point{4, 8}, math.Sqrt(5)},
+		{"scatter column", scatter, Point{108, nan}, "scatter", 2, Point{10, 5}, 2},
+		{"stack lower", stack, Point{60, 110}, "a", 1, Point{5, 2}, 0},
+		{"stack upper", stack, Point{60, 80}, "b", 1, Point{5, 3}, 0},
+		{"stack above", stack, Point{40, 40}, "b", 1, Point{5, 3}, 30},
+		{"stack column", stack, Point{18, nan}, "a", 0, Point{0, 2}, 0},
+	}
+
+	p := New()
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+	bounds := R(10, 20, 110, 120)
+
+	for _, test := range tests {
+		hit, ok := test.element.HitTest(p, bounds, test.at)
+		if !ok {
+			t.Errorf("%s: no hit", test.name)
+			continue
+		}
+		if hit.Label != test.label || hit.Index != test.index || !approxEqualPoint(hit.Value, test.value) {
+			t.Errorf("%s: got %q %d %v, expected %q %d %v", test.name, hit.Label, hit.Index, hit.Value, test.label, test.index, test.value)
+		}
+		if math.Abs(hit.Distance-test.distance) > 1e-9 {
+			t.Errorf("%s: got distance %v, expected %v", test.name, hit.Distance, test.distance)
+		}
+		if hit.Element != test.element {
+			t.Errorf("%s: got element %v", test.name, hit.Element)
+		}
+	}
+}
+
+func TestHitTestEmpty(t *testing.T) {
+	p := New()
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+	bounds := R(0, 0, 100, 100)
+
+	for _, element := range []hitElement{NewLine("", nil), NewStackedArea(nil)} {
+		if hit, ok := element.HitTest(p, bounds, Point{50, 50}); ok {
+			t.Errorf("%T: got %v, expected no hit", element, hit)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package plot
+
+import (
+	"image"
+	"math"
+)
+
+// Sampling determines how images are scaled.
+type Sampling byte
+
+const (
+	// SampleNearest uses the nearest pixel, which keeps cells sharp.
+	SampleNearest Sampling = iota
+	// SampleLinear interpolates between neighbouring pixels.
+	SampleLinear
+)
+
+// Picture implements drawing a raster image, e.g. a screenshot.
+type Picture struct {
+	Style
+	Image image.Image
+	// Bounds is the location of the image in data space.
+	// When empty, the image fills the whole canvas.
+	Bounds Rect
+}
+
+// NewPicture creates an image element filling the canvas.
+func NewPicture(img image.Image) *Picture {
+	return &Picture{Image: img}
+}
+
+// Stats calculates element statistics.
+func (picture *Picture) Stats() Stats {
+	if picture.Bounds.Empty() {
+		return nanStats
+	}
+	min := picture.Bounds.Min.Min(picture.Bounds.Max)
+	max := picture.Bounds.Min.Max(picture.Bounds.Max)
+	return Stats{
+		Min:    min,
+		Center: min.Add(max).Scale(0.5),
+		Max:    max,
+	}
+}
+
+// Draw draws the element to canvas.
+func (picture *Picture) Draw(plot *Plot, canvas Canvas) {
+	if picture.Image == nil {
+		return
+	}
+	canvas = canvas.Clip(canvas.Bounds())
+	dst := canvas.Bounds()
+	if !picture.Bounds.Empty() {
+		size := dst.Size()
+		dst = canonicalRect(
+			plot.X.ToCanvas(picture.Bounds.Min.X, 0, size.X),
+			plot.Y.ToCanvas(picture.Bounds.Min.Y, 0, size.Y),
+			plot.X.ToCanvas(picture.Bounds.Max.X, 0, size.X),
+			plot.Y.ToCanvas(picture.Bounds.Max.Y, 0, size.Y),
+		)
+	}
+	DrawImage(canvas, picture.Image, dst, &picture.Style)
+}
+
+// canonicalRect creates a rectangle where Min is smaller than Max.
+func canonicalRect(x0, y0, x1, y1 Length) Rect {
+	return R(math.Min(x0, x1), math.Min(y0, y1), math.Max(x0, x1), math.Max(y0, y1))
+}
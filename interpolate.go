@@ -0,0 +1,148 @@
+package plot
+
+import "math"
+
+// Interpolation determines how a line is drawn between consecutive points.
+type Interpolation byte
+
+const (
+	// InterpolateLinear draws straight lines between points.
+	InterpolateLinear Interpolation = iota
+	// InterpolateMonotone draws a cubic curve that preserves monotonicity
+	// of the data, i.e. it doesn't overshoot the points.
+	InterpolateMonotone
+	// InterpolateCatmullRom draws a Catmull–Rom spline through the points.
+	InterpolateCatmullRom
+	// InterpolateNatural draws a natural cubic spline through the points.
+	InterpolateNatural
+)
+
+// draw draws canvas space points using the interpolation.
+func (mode Interpolation) draw(canvas Canvas, points []Point, style *Style) {
+	if mode == InterpolateLinear || len(points) < 3 {
+		canvas.Poly(points, style)
+		return
+	}
+	DrawPath(canvas, mode.curve(points), style)
+}
+
+// curve creates a path through points consisting of cubic curves.
+func (mode Interpolation) curve(points []Point) *Path {
+	path := &Path{}
+	path.MoveTo(points[0])
+
+	switch mode {
+	case InterpolateMonotone:
+		tangents := monotoneTangents(points)
+		for i := 0; i < len(points)-1; i++ {
+			p0, p1 := points[i], points[i+1]
+			h := (p1.X - p0.X) / 3
+			path.CubicTo(
+				Point{p0.X + h, p0.Y + tangents[i]*h},
+				Point{p1.X - h, p1.Y - tangents[i+1]*h},
+				p1,
+			)
+		}
+	case InterpolateCatmullRom:
+		at := func(i int) Point {
+			if i < 0 {
+				return points[0]
+			}
+			if i >= len(points) {
+				return points[len(points)-1]
+			}
+			return points[i]
+		}
+		for i := 0; i < len(points)-1; i++ {
+			p0, p1 := at(i), at(i+1)
+			path.CubicTo(
+				p0.Add(p1.Sub(at(i-1)).Scale(1.0/6)),
+				p1.Sub(at(i+2).Sub(p0).Scale(1.0/6)),
+				p1,
+			)
+		}
+	case InterpolateNatural:
+		xs := make([]float64, len(points))
+		ys := make([]float64, len(points))
+		for i, p := range points {
+			xs[i], ys[i] = p.X, p.Y
+		}
+		ax, bx := naturalControls(xs)
+		ay, by := naturalControls(ys)
+		for i := 0; i < len(points)-1; i++ {
+			path.CubicTo(Point{ax[i], ay[i]}, Point{bx[i], by[i]}, points[i+1])
+		}
+	default:
+		for _, p := range points[1:] {
+			path.LineTo(p)
+		}
+	}
+
+	return path
+}
+
+// monotoneTangents calculates the tangents for monotone cubic interpolation
+// using the method by Steffen.
+func monotoneTangents(points []Point) []float64 {
+	n := len(points)
+	secants := make([]float64, n-1)
+	for i := range secants {
+		if h := points[i+1].X - points[i].X; h != 0 {
+			secants[i] = (points[i+1].Y - points[i].Y) / h
+		}
+	}
+
+	tangents := make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		s0, s1 := secants[i-1], secants[i]
+		if s0*s1 <= 0 {
+			continue
+		}
+		h0, h1 := points[i].X-points[i-1].X, points[i+1].X-points[i].X
+		p := (s0*h1 + s1*h0) / (h0 + h1)
+		tangents[i] = (sign(s0) + sign(s1)) * math.Min(math.Min(math.Abs(s0), math.Abs(s1)), 0.5*math.Abs(p))
+	}
+	// one-sided tangents at the ends
+	tangents[0] = (3*secants[0] - tangents[1]) / 2
+	tangents[n-1] = (3*secants[n-2] - tangents[n-2]) / 2
+	if tangents[0]*secants[0] <= 0 {
+		tangents[0] = 0
+	}
+	if tangents[n-1]*secants[n-2] <= 0 {
+		tangents[n-1] = 0
+	}
+
+	return tangents
+}
+
+// naturalControls calculates the Bézier control points of a natural cubic spline
+// through values by solving the tridiagonal system.
+func naturalControls(values []float64) (a, b []float64) {
+	n := len(values) - 1
+	a, b = make([]float64, n), make([]float64, n)
+	r := make([]float64, n)
+
+	a[0], b[0], r[0] = 0, 2, values[0]+2*values[1]
+	for i := 1; i < n-1; i++ {
+		a[i], b[i], r[i] = 1, 4, 4*values[i]+2*values[i+1]
+	}
+	a[n-1], b[n-1], r[n-1] = 2, 7, 8*values[n-1]+values[n]
+
+	for i := 1; i < n; i++ {
+		m := a[i] / b[i-1]
+		b[i] -= m
+		r[i] -= m * r[i-1]
+	}
+
+	a[n-1] = r[n-1] / b[n-1]
+	for i := n - 2; i >= 0; i-- {
+		a[i] = (r[i] - a[i+1]) / b[i]
+	}
+
+	b[n-1] = (values[n] + a[n-1]) / 2
+	for i := 0; i < n-1; i++ {
+		b[i] = 2*values[i+1] - a[i+1]
+	}
+
+	return a, b
+}
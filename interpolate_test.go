@@ -0,0 +1,66 @@
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMonotoneStaysWithinSegments(t *testing.T) {
+	points := []Point{{0, 0}, {1, 0}, {1.5, 0}, {2, 100}, {5, 100}, {6, 99}, {6.2, 0}, {9, 0}}
+	lines := InterpolateMonotone.curve(points).Flatten(0.01)
+	if len(lines) != 1 {
+		t.Fatalf("got %d subpaths, expected 1", len(lines))
+	}
+
+	for _, p := range lines[0] {
+		for i := 0; i < len(points)-1; i++ {
+			p0, p1 := points[i], points[i+1]
+			if p.X < p0.X || p.X > p1.X {
+				continue
+			}
+			low, high := math.Min(p0.Y, p1.Y), math.Max(p0.Y, p1.Y)
+			if p.Y < low-1e-9 || p.Y > high+1e-9 {
+				t.Errorf("%v overshoots segment %v-%v", p, p0, p1)
+			}
+		}
+	}
+}
+
+func TestSplinesPassThroughPoints(t *testing.T) {
+	points := []Point{{0, 0}, {1, 3}, {2, -1}, {4, 5}, {5, 5}, {7, 0}}
+	tests := []struct {
+		name string
+		mode Interpolation
+	}{
+		{"catmull-rom", InterpolateCatmullRom},
+		{"natural", InterpolateNatural},
+	}
+
+	for _, test := range tests {
+		path := test.mode.curve(points)
+		lines := path.Flatten(0.01)
+		if len(lines) != 1 {
+			t.Fatalf("%s: got %d subpaths, expected 1", test.name, len(lines))
+		}
+		for _, p := range points {
+			found := false
+			for _, q := range lines[0] {
+				found = found || approxEqualPoint(p, q)
+			}
+			if !found {
+				t.Errorf("%s: curve doesn't pass through %v", test.name, p)
+			}
+		}
+
+		// the curve is smooth at the points
+		segments := path.Segments[1:]
+		for i := 1; i < len(segments); i++ {
+			p := points[i]
+			in := p.Sub(segments[i-1].Points[1])
+			out := segments[i].Points[0].Sub(p)
+			if math.Abs(in.X*out.Y-in.Y*out.X) > 1e-9 || in.X*out.X+in.Y*out.Y <= 0 {
+				t.Errorf("%s: curve has a corner at %v: %v, %v", test.name, p, in, out)
+			}
+		}
+	}
+}
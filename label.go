@@ -18,17 +18,28 @@ func NewXLabel(text string) *Label {
 	}
 }
 
-// Draw draws the element to canvas.
-func (label *Label) Draw(plot *Plot, canvas Canvas) {
-	style := &label.Style
-	if style == nil {
+// style returns the style used for drawing the label,
+// labels without a color, size or font use the theme font.
+func (label *Label) style(plot *Plot) *Style {
+	if label.Style.IsZero() {
 		t := plot.Theme.Font
 		t.Origin = label.Style.Origin
-		style = &t
+		t.Rotation = label.Style.Rotation
+		return &t
 	}
+	return &label.Style
+}
 
+// Draw draws the element to canvas.
+func (label *Label) Draw(plot *Plot, canvas Canvas) {
 	bounds := canvas.Bounds()
 	at := bounds.UnitLocation(label.Placement)
 
-	canvas.Text(label.Text, at, style)
+	canvas.Text(label.Text, at, label.style(plot))
+}
+
+// Overflow calculates how much the label extends beyond bounds on each side.
+func (label *Label) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	at := bounds.UnitLocation(label.Placement)
+	return overflowOf(bounds, measurer.MeasureText(label.Text, label.style(plot)).Offset(at))
 }
@@ -0,0 +1,114 @@
+package plot
+
+import (
+	"math"
+	"sort"
+)
+
+// LabelPlacement determines how overlapping tick labels are resolved.
+type LabelPlacement byte
+
+const (
+	// LabelsOverlap draws all labels, even when they overlap.
+	LabelsOverlap LabelPlacement = iota
+	// LabelsDrop drops the less important labels until they don't overlap.
+	LabelsDrop
+	// LabelsRotate rotates the labels by 45° or 90° and then drops labels when necessary.
+	// Labels on vertical axes are only dropped.
+	LabelsRotate
+	// LabelsStagger alternates the labels between two rows and then drops labels when necessary.
+	LabelsStagger
+)
+
+// defaultLabelSpacing is the minimum distance between labels.
+const defaultLabelSpacing = 4
+
+// tickLabel is a label placed at a tick.
+type tickLabel struct {
+	text string
+	at   Point
+	// pos is the position along the axis.
+	pos Length
+	// min, max are the extents along the axis.
+	min, max Length
+	priority float64
+}
+
+// tickPriority calculates how important a tick value is,
+// rounder values have a higher priority.
+func tickPriority(v float64) float64 {
+	if v == 0 {
+		return math.Inf(1)
+	}
+	for k := 15; k >= -15; k-- {
+		scaled := v / math.Pow(10, float64(k))
+		rounded := math.Round(scaled)
+		if rounded != 0 && math.Abs(scaled-rounded) < 1e-9*math.Abs(scaled) {
+			if math.Mod(rounded, 5) == 0 {
+				return float64(k) + 0.5
+			}
+			return float64(k)
+		}
+	}
+	return math.Inf(-1)
+}
+
+// sortLabels sorts labels along the axis.
+func sortLabels(labels []tickLabel) {
+	sort.SliceStable(labels, func(i, k int) bool { return labels[i].pos < labels[k].pos })
+}
+
+// fitLabels checks whether labels in the same row don't overlap.
+func fitLabels(labels []tickLabel, rows int, spacing Length) bool {
+	for i := rows; i < len(labels); i++ {
+		a, b := labels[i-rows], labels[i]
+		if a.max+spacing > b.min && b.max+spacing > a.min {
+			return false
+		}
+	}
+	return true
+}
+
+// dropLabels keeps every n-th label with the smallest n that fits,
+// choosing the offset with the most important labels.
+func dropLabels(labels []tickLabel, rows int, spacing Length) []tickLabel {
+	if fitLabels(labels, rows, spacing) {
+		return labels
+	}
+
+	subset := make([]tickLabel, 0, len(labels))
+	for step := 2; step < len(labels); step++ {
+		var best []tickLabel
+		bestScore := math.Inf(-1)
+		for offset := 0; offset < step; offset++ {
+			subset = subset[:0]
+			for i := offset; i < len(labels); i += step {
+				subset = append(subset, labels[i])
+			}
+			if !fitLabels(subset, rows, spacing) {
+				continue
+			}
+
+			score := 0.0
+			for _, label := range subset {
+				score += math.Min(label.priority, 100)
+			}
+			if score > bestScore {
+				best = append(best[:0:0], subset...)
+				bestScore = score
+			}
+		}
+		if best != nil {
+			return best
+		}
+	}
+
+	// keep the most important label
+	best := labels[0]
+	for _, label := range labels[1:] {
+		if label.priority > best.priority {
+			best = label
+		}
+	}
+	return []tickLabel{best}
+}
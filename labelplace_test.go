@@ -0,0 +1,75 @@
+package plot
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTickPriority(t *testing.T) {
+	tests := []struct {
+		value    float64
+		expected float64
+	}{
+		{0, math.Inf(1)},
+		{1, 0},
+		{2, 0},
+		{5, 0.5},
+		{10, 1},
+		{50, 1.5},
+		{-300, 2},
+		{0.1, -1},
+		{0.5, -0.5},
+		{0.25, -1.5},
+		{0.125, -2.5},
+		{0.123, -3},
+		{1e-20, math.Inf(-1)},
+		{math.NaN(), math.Inf(-1)},
+	}
+
+	for _, test := range tests {
+		if got := tickPriority(test.value); got != test.expected {
+			t.Errorf("%v: got %v, expected %v", test.value, got, test.expected)
+		}
+	}
+}
+
+func TestDropLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		width    Length
+		rows     int
+		expected []string
+	}{
+		{"fits", []float64{0, 1, 2}, 4, 1, []string{"0", "1", "2"}},
+		{"every second", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 8, 1, []string{"0", "2", "4", "6", "8", "10"}},
+		{"every second offset", []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, 8, 1, []string{"2", "4", "6", "8", "10"}},
+		{"every third", []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 18, 1, []string{"0", "3", "6", "9"}},
+		{"staggered", []float64{0, 1, 2, 3, 4}, 14, 2, []string{"0", "1", "2", "3", "4"}},
+		{"most important", []float64{3, 5}, 30, 1, []string{"5"}},
+	}
+
+	for _, test := range tests {
+		labels := make([]tickLabel, len(test.values))
+		for i, v := range test.values {
+			pos := Length(i * 10)
+			labels[i] = tickLabel{
+				text:     strconv.FormatFloat(v, 'g', -1, 64),
+				pos:      pos,
+				min:      pos - test.width/2,
+				max:      pos + test.width/2,
+				priority: tickPriority(v),
+			}
+		}
+
+		var got []string
+		for _, label := range dropLabels(labels, test.rows, defaultLabelSpacing) {
+			got = append(got, label.text)
+		}
+		if strings.Join(got, " ") != strings.Join(test.expected, " ") {
+			t.Errorf("%s: got %v, expected %v", test.name, got, test.expected)
+		}
+	}
+}
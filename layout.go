@@ -0,0 +1,163 @@
+package plot
+
+import "math"
+
+// TextMeasurer measures the extents of text.
+type TextMeasurer interface {
+	// MeasureText returns the bounds of text drawn at (0, 0) using style,
+	// taking Origin and Rotation into account.
+	MeasureText(text string, style *Style) Rect
+}
+
+// ApproxTextMeasurer approximates text extents from font size.
+type ApproxTextMeasurer struct{}
+
+// MeasureText returns the approximate bounds of text drawn at (0, 0).
+func (ApproxTextMeasurer) MeasureText(text string, style *Style) Rect {
+	size := style.Size
+	if size == 0 {
+		size = 12
+	}
+	width := Length(len([]rune(text))) * size * 0.6
+	return TextBounds(width, size*0.8, size*0.2, style)
+}
+
+// TextBounds calculates text bounds relative to its position
+// from the unrotated width, ascent and descent of the text.
+func TextBounds(width, ascent, descent Length, style *Style) Rect {
+	height := ascent + descent
+
+	x0 := -(style.Origin.X + 1) * 0.5 * width
+
+	top, middle, baseline := 0.0, -height*0.5, -ascent
+	var y0 Length
+	if style.Origin.Y <= 0 {
+		y0 = lerp(style.Origin.Y+1, top, middle)
+	} else {
+		y0 = lerp(style.Origin.Y, middle, baseline)
+	}
+
+	r := R(x0, y0, x0+width, y0+height)
+	if style.Rotation == 0 {
+		return r
+	}
+
+	sin, cos := math.Sincos(style.Rotation)
+	rotated := Rect{Min: Point{math.Inf(1), math.Inf(1)}, Max: Point{math.Inf(-1), math.Inf(-1)}}
+	for _, p := range r.Points()[:4] {
+		p = Point{p.X*cos - p.Y*sin, p.X*sin + p.Y*cos}
+		rotated.Min = rotated.Min.Min(p)
+		rotated.Max = rotated.Max.Max(p)
+	}
+	return rotated
+}
+
+// textMeasurer returns the measurer of the canvas or an approximation.
+func textMeasurer(canvas Canvas) TextMeasurer {
+	if measurer, ok := canvas.(TextMeasurer); ok {
+		return measurer
+	}
+	return ApproxTextMeasurer{}
+}
+
+// Overflower is an element that draws outside of its bounds, e.g. tick labels.
+type Overflower interface {
+	// Overflow calculates how much the element extends beyond bounds on each side.
+	Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect
+}
+
+// Layouter is an element that arranges its children and computes their margins.
+type Layouter interface {
+	// Layout updates the margins of the children such that they fit into bounds.
+	Layout(plot *Plot, measurer TextMeasurer, bounds Rect)
+}
+
+// Layout updates plot and container margins such that tick labels, titles
+// and other elements drawn outside of the plot area fit into the canvas.
+//
+// The margins are calculated by adding the overflow to the padding.
+func (plot *Plot) Layout(canvas Canvas) {
+	if !plot.X.IsValid() || !plot.Y.IsValid() {
+		tmpplot := &Plot{}
+		*tmpplot = *plot
+		tmpplot.X, tmpplot.Y = detectAxis(plot.X, plot.Y, plot.Elements)
+		tmpplot.Layout(canvas)
+		plot.Margin = tmpplot.Margin
+		return
+	}
+
+	measurer := textMeasurer(canvas)
+	bounds := canvas.Bounds()
+
+	// reserve space for the headings
+	padding := addInsets(plot.Padding, plot.titleInsets(measurer))
+
+	plot.Margin = layoutMargin(plot, measurer, padding, []Element{plot.Elements}, func(int) Rect { return bounds })
+}
+
+// layoutMargin calculates the margin for elements placed in blocks and
+// lays out the elements.
+func layoutMargin(plot *Plot, measurer TextMeasurer, padding Rect, els []Element, block func(i int) Rect) Rect {
+	margin := padding
+	// overflow depends on the bounds, so iterate to get a better estimate
+	for iteration := 0; iteration < 2; iteration++ {
+		var overflow Rect
+		for i, el := range els {
+			bounds := block(i).Inset(margin).Zero()
+			overflow = maxInsets(overflow, elementOverflow(plot, measurer, el, bounds))
+		}
+		margin = addInsets(padding, overflow)
+	}
+
+	for i, el := range els {
+		layoutElement(plot, measurer, el, block(i).Inset(margin).Zero())
+	}
+	return margin
+}
+
+// elementOverflow calculates overflow of el.
+func elementOverflow(plot *Plot, measurer TextMeasurer, el Element, bounds Rect) Rect {
+	switch el := el.(type) {
+	case Overflower:
+		return el.Overflow(plot, measurer, bounds)
+	case Elements:
+		var overflow Rect
+		for _, child := range el {
+			overflow = maxInsets(overflow, elementOverflow(plot, measurer, child, bounds))
+		}
+		return overflow
+	}
+	return Rect{}
+}
+
+// layoutElement lays out el.
+func layoutElement(plot *Plot, measurer TextMeasurer, el Element, bounds Rect) {
+	switch el := el.(type) {
+	case Layouter:
+		el.Layout(plot, measurer, bounds)
+	case Elements:
+		for _, child := range el {
+			layoutElement(plot, measurer, child, bounds)
+		}
+	}
+}
+
+// overflowOf calculates how much r extends beyond bounds.
+func overflowOf(bounds, r Rect) Rect {
+	return Rect{
+		Min: Point{
+			X: math.Max(0, bounds.Min.X-r.Min.X),
+			Y: math.Max(0, bounds.Min.Y-r.Min.Y),
+		},
+		Max: Point{
+			X: math.Max(0, r.Max.X-bounds.Max.X),
+			Y: math.Max(0, r.Max.Y-bounds.Max.Y),
+		},
+	}
+}
+
+// maxInsets returns the larger inset on each side.
+func maxInsets(a, b Rect) Rect { return Rect{a.Min.Max(b.Min), a.Max.Max(b.Max)} }
+
+// addInsets adds insets on each side.
+func addInsets(a, b Rect) Rect { return Rect{a.Min.Add(b.Min), a.Max.Add(b.Max)} }
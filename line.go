@@ -1,13 +1,20 @@
 package plot
 
-import "math"
-
 // Line implements a simple line plot.
 type Line struct {
 	Style
 	Label string
 
 	Data []Point
+	// Gaps determines how missing values are drawn.
+	Gaps GapMode
+	// Step determines whether the line is drawn as a staircase.
+	Step StepMode
+	// Interpolation determines how the line is drawn between points,
+	// it's ignored when Step is used.
+	Interpolation Interpolation
+
+	sorted sortedCache
 }
 
 // NewLine creates a new line element from the given points.
@@ -26,23 +33,41 @@ func (line *Line) Stats() Stats {
 // Draw draws the element to canvas.
 func (line *Line) Draw(plot *Plot, canvas Canvas) {
 	canvas = canvas.Clip(canvas.Bounds())
-	points := project(line.Data, plot.X, plot.Y, canvas.Bounds())
+	points := plot.project(line.Step.expand(line.Data), canvas.Bounds())
+
+	interpolation := line.Interpolation
+	if line.Step != StepNone {
+		interpolation = InterpolateLinear
+	}
 
 	if !line.Style.IsZero() {
-		canvas.Poly(points, &line.Style)
+		line.Gaps.drawPolyline(canvas, points, &line.Style, &plot.Theme.Gap, nil, interpolation)
 	} else {
-		canvas.Poly(points, &plot.Theme.Line)
+		line.Gaps.drawPolyline(canvas, points, plot.autoStyle(line, &plot.Theme.Line), &plot.Theme.Gap, nil, interpolation)
 	}
 }
 
-// OptimizedLine implements a simple line plot.
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (line *Line) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	return hitTestPoints(line, line.Label, line.Data, &line.sorted, plot, bounds, at)
+}
+
+// OptimizedLine implements a line plot that simplifies the line before drawing.
 type OptimizedLine struct {
 	Style
 	Label string
 
 	Data []Point
+	// Gaps determines how missing values are drawn.
+	Gaps GapMode
 
+	// ThresholdPx is used for the default collinear simplification.
 	ThresholdPx float64
+	// Simplifier reduces the number of points in canvas space.
+	// When nil, nearly collinear points are dropped using ThresholdPx.
+	Simplifier LineSimplifier
+
+	sorted sortedCache
 }
 
 // NewOptimizedLine creates a new line element that tries to optimize drawing.
@@ -62,48 +87,28 @@ func (line *OptimizedLine) Stats() Stats {
 // Draw draws the element to canvas.
 func (line *OptimizedLine) Draw(plot *Plot, canvas Canvas) {
 	canvas = canvas.Clip(canvas.Bounds())
-	points := project(line.Data, plot.X, plot.Y, canvas.Bounds())
-
-	const optimizeCount = 100
-	if len(points) < optimizeCount {
-		if !line.Style.IsZero() {
-			canvas.Poly(points, &line.Style)
-		} else {
-			canvas.Poly(points, &plot.Theme.Line)
-		}
-		return
-	}
+	points := plot.project(line.Data, canvas.Bounds())
 
-	// always include the first point
-	optimized := points[:1]
-
-	prev := points[0]
-	mid := points[1]
-	for _, next := range points[2:] {
-		// does the mid change significantly from the previous?
-		if math.Abs(prev.Y-mid.Y) < line.ThresholdPx && math.Abs(prev.X-mid.X) < line.ThresholdPx {
-			mid = next
-			continue
-		}
-
-		// is the mid on the line from prev to next?
-		p := invlerp(mid.X, prev.X, next.X)
-		knownY := lerp(p, prev.Y, next.Y)
-		if math.Abs(knownY-mid.X) < line.ThresholdPx {
-			mid = next
-			continue
+	var simplifier LineSimplifier = CollinearSimplifier{Threshold: line.ThresholdPx}
+	if line.Simplifier != nil {
+		simplifier = line.Simplifier
+	}
+	simplify := func(points []Point) []Point {
+		const optimizeCount = 100
+		if len(points) < optimizeCount {
+			return points
 		}
-
-		// otherwise let's output
-		optimized = append(optimized, mid)
-		prev, mid = mid, next
+		return simplifier.Simplify(points)
 	}
-	// add the final point
-	optimized = append(optimized, points[len(points)-1])
 
 	if !line.Style.IsZero() {
-		canvas.Poly(optimized, &line.Style)
+		line.Gaps.drawPolyline(canvas, points, &line.Style, &plot.Theme.Gap, simplify, InterpolateLinear)
 	} else {
-		canvas.Poly(optimized, &plot.Theme.Line)
+		line.Gaps.drawPolyline(canvas, points, plot.autoStyle(line, &plot.Theme.Line), &plot.Theme.Gap, simplify, InterpolateLinear)
 	}
 }
+
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (line *OptimizedLine) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	return hitTestPoints(line, line.Label, line.Data, &line.sorted, plot, bounds, at)
+}
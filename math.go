@@ -90,3 +90,13 @@ func Int64sToFloat64s(xs []int64) []float64 {
 	}
 	return r
 }
+
+// isFinite returns whether v is neither NaN nor infinity.
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// isFinitePoint returns whether both coordinates are finite.
+func isFinitePoint(p Point) bool {
+	return isFinite(p.X) && isFinite(p.Y)
+}
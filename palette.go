@@ -0,0 +1,214 @@
+package plot
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Palette is a list of distinct colors for categorical data.
+type Palette []color.Color
+
+// At returns the i-th color, wrapping around when i is out of range.
+func (palette Palette) At(i int) color.Color {
+	if len(palette) == 0 {
+		return nil
+	}
+	i %= len(palette)
+	if i < 0 {
+		i += len(palette)
+	}
+	return palette[i]
+}
+
+// PerceptualColormap interpolates between evenly spaced colors in Oklab color space.
+type PerceptualColormap []color.Color
+
+// At returns the color for t in range 0..1.
+func (colors PerceptualColormap) At(t float64) color.Color {
+	if len(colors) == 0 {
+		return nil
+	}
+	if len(colors) == 1 || math.IsNaN(t) || t <= 0 {
+		return colors[0]
+	}
+	if t >= 1 {
+		return colors[len(colors)-1]
+	}
+
+	p := t * float64(len(colors)-1)
+	i := int(p)
+	return InterpolateColor(p-float64(i), colors[i], colors[i+1])
+}
+
+// Categorical palettes.
+var (
+	// OkabeIto is a colorblind-safe palette by Masataka Okabe and Kei Ito.
+	OkabeIto = Palette(hexColors("#e69f00", "#56b4e9", "#009e73", "#f0e442", "#0072b2", "#d55e00", "#cc79a7", "#000000"))
+	// Tableau10 is the default palette of Tableau.
+	Tableau10 = Palette(hexColors("#4e79a7", "#f28e2b", "#e15759", "#76b7b2", "#59a14f", "#edc948", "#b07aa1", "#ff9da7", "#9c755f", "#bab0ac"))
+	// Grayscale is a palette of distinguishable gray levels.
+	Grayscale = Palette(hexColors("#000000", "#555555", "#888888", "#aaaaaa"))
+)
+
+// Continuous colormaps.
+var (
+	// Viridis is a perceptually uniform colormap from dark blue to yellow.
+	Viridis = PerceptualColormap(hexColors("#440154", "#472d7b", "#3b528b", "#2c728e", "#21918c", "#28ae80", "#5ec962", "#addc30", "#fde725"))
+	// Magma is a perceptually uniform colormap from black to light yellow.
+	Magma = PerceptualColormap(hexColors("#000004", "#180f3d", "#440f76", "#721f81", "#9e2f7f", "#cd4071", "#f1605d", "#fd9668", "#feca8d", "#fcfdbf"))
+	// Cividis is a perceptually uniform colormap optimized for color vision deficiency.
+	Cividis = PerceptualColormap(hexColors("#00224e", "#123570", "#3b496c", "#575d6d", "#707173", "#8a8779", "#a69d75", "#c4b56c", "#e4cf5b", "#fee838"))
+)
+
+// Diverging colormaps, with a neutral color in the middle.
+var (
+	// RedBlue diverges from red to blue.
+	RedBlue = PerceptualColormap(hexColors("#67001f", "#b2182b", "#d6604d", "#f4a582", "#fddbc7", "#f7f7f7", "#d1e5f0", "#92c5de", "#4393c3", "#2166ac", "#053061"))
+	// PurpleOrange diverges from purple to orange.
+	PurpleOrange = PerceptualColormap(hexColors("#2d004b", "#542788", "#8073ac", "#b2abd2", "#d8daeb", "#f7f7f7", "#fee0b6", "#fdb863", "#e08214", "#b35806", "#7f3b08"))
+)
+
+// ParseHexColor parses colors in #rgb, #rgba, #rrggbb and #rrggbbaa format.
+func ParseHexColor(s string) (color.NRGBA, bool) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) == 3 || len(s) == 4 {
+		expanded := make([]byte, 0, len(s)*2)
+		for i := 0; i < len(s); i++ {
+			expanded = append(expanded, s[i], s[i])
+		}
+		s = string(expanded)
+	}
+	if len(s) == 6 {
+		s += "ff"
+	}
+	if len(s) != 8 {
+		return color.NRGBA{}, false
+	}
+
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, false
+	}
+	return color.NRGBA{
+		R: uint8(v >> 24),
+		G: uint8(v >> 16),
+		B: uint8(v >> 8),
+		A: uint8(v),
+	}, true
+}
+
+// hexColors parses a list of hex colors and panics on invalid values.
+func hexColors(hexes ...string) []color.Color {
+	colors := make([]color.Color, 0, len(hexes))
+	for _, hex := range hexes {
+		c, ok := ParseHexColor(hex)
+		if !ok {
+			panic("invalid color " + hex)
+		}
+		colors = append(colors, c)
+	}
+	return colors
+}
+
+// InterpolateColor interpolates between colors a and b in Oklab color space.
+func InterpolateColor(t float64, a, b color.Color) color.Color {
+	ca, cb := color.NRGBAModel.Convert(a).(color.NRGBA), color.NRGBAModel.Convert(b).(color.NRGBA)
+	la, lb := toOklab(ca), toOklab(cb)
+
+	var mix oklab
+	for i := range mix {
+		mix[i] = lerp(t, la[i], lb[i])
+	}
+	c := fromOklab(mix)
+	c.A = uint8(math.Round(lerp(t, float64(ca.A), float64(cb.A))))
+	return c
+}
+
+// oklab is a color in Oklab color space as L, a, b.
+type oklab [3]float64
+
+// toOklab converts sRGB color to Oklab, ignoring alpha.
+func toOklab(c color.NRGBA) oklab {
+	r, g, b := srgbToLinear(c.R), srgbToLinear(c.G), srgbToLinear(c.B)
+
+	l := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	m := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	s := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	return oklab{
+		0.2104542553*l + 0.7936177850*m - 0.0040720468*s,
+		1.9779984951*l - 2.4285922050*m + 0.4505937099*s,
+		0.0259040371*l + 0.7827717662*m - 0.8086757660*s,
+	}
+}
+
+// fromOklab converts Oklab color to opaque sRGB.
+func fromOklab(c oklab) color.NRGBA {
+	l := c[0] + 0.3963377774*c[1] + 0.2158037573*c[2]
+	m := c[0] - 0.1055613458*c[1] - 0.0638541728*c[2]
+	s := c[0] - 0.0894841775*c[1] - 1.2914855480*c[2]
+	l, m, s = l*l*l, m*m*m, s*s*s
+
+	return color.NRGBA{
+		R: linearToSRGB(+4.0767416621*l - 3.3077115913*m + 0.2309699292*s),
+		G: linearToSRGB(-1.2684380046*l + 2.6097574011*m - 0.3413193965*s),
+		B: linearToSRGB(-0.0041960863*l - 0.7034186147*m + 1.7076147010*s),
+		A: 0xff,
+	}
+}
+
+// srgbToLinear converts sRGB component to linear light.
+func srgbToLinear(v uint8) float64 {
+	x := float64(v) / 0xff
+	if x <= 0.04045 {
+		return x / 12.92
+	}
+	return math.Pow((x+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB converts linear light to sRGB component.
+func linearToSRGB(x float64) uint8 {
+	if x <= 0.0031308 {
+		x *= 12.92
+	} else {
+		x = 1.055*math.Pow(x, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(math.Max(0, math.Min(1, x)) * 0xff))
+}
+
+// paletteAssignment tracks the palette colors assigned to unstyled elements.
+type paletteAssignment struct {
+	assigned map[interface{}]int
+}
+
+// autoStyle returns the default style for the element identified by key.
+//
+// When the theme has a palette, each unstyled element gets the next
+// color from the palette in drawing order, otherwise it returns base.
+func (plot *Plot) autoStyle(key interface{}, base *Style) *Style {
+	if len(plot.Theme.Palette) == 0 || plot.palette == nil {
+		return base
+	}
+
+	index, ok := plot.palette.assigned[key]
+	if !ok {
+		index = len(plot.palette.assigned)
+		plot.palette.assigned[key] = index
+	}
+
+	c := plot.Theme.Palette.At(index)
+	style := *base
+	if style.Stroke != nil {
+		style.Stroke = c
+	}
+	if style.Fill != nil {
+		_, _, _, alpha := style.Fill.RGBA()
+		style.Fill = fadeColor(c, uint8(alpha>>8))
+	}
+	if style.Stroke == nil && style.Fill == nil {
+		style.Stroke = c
+	}
+	return &style
+}
@@ -0,0 +1,62 @@
+package plot
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestParseHexColor(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected color.NRGBA
+		ok       bool
+	}{
+		{"#000", color.NRGBA{0, 0, 0, 255}, true},
+		{"#fa0", color.NRGBA{255, 170, 0, 255}, true},
+		{"#fa08", color.NRGBA{255, 170, 0, 136}, true},
+		{"#4e79a7", color.NRGBA{78, 121, 167, 255}, true},
+		{"4E79A7", color.NRGBA{78, 121, 167, 255}, true},
+		{"#4e79a780", color.NRGBA{78, 121, 167, 128}, true},
+		{"", color.NRGBA{}, false},
+		{"#", color.NRGBA{}, false},
+		{"#12345", color.NRGBA{}, false},
+		{"#123456789", color.NRGBA{}, false},
+		{"#ggg", color.NRGBA{}, false},
+		{"#-12345", color.NRGBA{}, false},
+	}
+
+	for _, test := range tests {
+		got, ok := ParseHexColor(test.in)
+		if got != test.expected || ok != test.ok {
+			t.Errorf("%q: got %v %v, expected %v %v", test.in, got, ok, test.expected, test.ok)
+		}
+	}
+}
+
+func TestOklabRoundTrip(t *testing.T) {
+	tests := []color.NRGBA{
+		{0, 0, 0, 255},
+		{255, 255, 255, 255},
+		{255, 0, 0, 255},
+		{0, 255, 0, 255},
+		{0, 0, 255, 255},
+		{78, 121, 167, 255},
+		{1, 2, 3, 255},
+		{254, 128, 7, 255},
+	}
+
+	for _, c := range tests {
+		if got := fromOklab(toOklab(c)); got != c {
+			t.Errorf("%v: got %v after round-trip", c, got)
+		}
+	}
+
+	// interpolation ends at the original colors
+	a, b := color.NRGBA{255, 0, 0, 255}, color.NRGBA{0, 0, 255, 0}
+	if got := InterpolateColor(0, a, b); got != a {
+		t.Errorf("t=0: got %v, expected %v", got, a)
+	}
+	if got := InterpolateColor(1, a, b); got != (color.NRGBA{0, 0, 255, 0}) {
+		t.Errorf("t=1: got %v, expected %v", got, b)
+	}
+}
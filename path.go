@@ -0,0 +1,199 @@
+package plot
+
+import "math"
+
+// PathOp is the operation of a path segment.
+type PathOp byte
+
+const (
+	// PathMoveTo starts a new subpath at Points[0].
+	PathMoveTo PathOp = iota
+	// PathLineTo draws a line to Points[0].
+	PathLineTo
+	// PathQuadTo draws a quadratic Bézier curve with control Points[0] to Points[1].
+	PathQuadTo
+	// PathCubicTo draws a cubic Bézier curve with controls Points[0], Points[1] to Points[2].
+	PathCubicTo
+	// PathClose draws a line to the start of the subpath.
+	PathClose
+)
+
+// PathSegment is a single segment of a path.
+type PathSegment struct {
+	Op     PathOp
+	Points [3]Point
+}
+
+// End returns the end point of the segment, Close segments don't have one.
+func (segment *PathSegment) End() Point {
+	switch segment.Op {
+	case PathQuadTo:
+		return segment.Points[1]
+	case PathCubicTo:
+		return segment.Points[2]
+	}
+	return segment.Points[0]
+}
+
+// Path describes a shape consisting of lines and curves.
+type Path struct {
+	Segments []PathSegment
+
+	// pen is the current position and start is the start of the subpath.
+	pen, start Point
+}
+
+// NewEllipsePath creates a closed path approximating an ellipse with cubic curves.
+func NewEllipsePath(center, radius Point) *Path {
+	path := &Path{}
+	path.MoveTo(Point{center.X + radius.X, center.Y})
+	for i := 0; i < 4; i++ {
+		path.ellipseArc(center, radius, float64(i)*math.Pi/2, math.Pi/2)
+	}
+	path.Close()
+	return path
+}
+
+// MoveTo starts a new subpath at p.
+func (path *Path) MoveTo(p Point) {
+	path.Segments = append(path.Segments, PathSegment{Op: PathMoveTo, Points: [3]Point{p}})
+	path.pen, path.start = p, p
+}
+
+// LineTo draws a line to p.
+func (path *Path) LineTo(p Point) {
+	path.Segments = append(path.Segments, PathSegment{Op: PathLineTo, Points: [3]Point{p}})
+	path.pen = p
+}
+
+// QuadTo draws a quadratic Bézier curve to p.
+func (path *Path) QuadTo(ctrl, p Point) {
+	path.Segments = append(path.Segments, PathSegment{Op: PathQuadTo, Points: [3]Point{ctrl, p}})
+	path.pen = p
+}
+
+// CubicTo draws a cubic Bézier curve to p.
+func (path *Path) CubicTo(ctrl0, ctrl1, p Point) {
+	path.Segments = append(path.Segments, PathSegment{Op: PathCubicTo, Points: [3]Point{ctrl0, ctrl1, p}})
+	path.pen = p
+}
+
+// ArcTo draws a circular arc around center, starting from the current position
+// and turning sweep radians. Positive sweep turns from +X towards +Y.
+//
+// The arc is approximated with cubic Bézier curves.
+func (path *Path) ArcTo(center Point, sweep float64) {
+	d := path.pen.Sub(center)
+	r := math.Hypot(d.X, d.Y)
+	start := math.Atan2(d.Y, d.X)
+
+	n := int(math.Ceil(math.Abs(sweep) / (math.Pi / 2)))
+	for i := 0; i < n; i++ {
+		path.ellipseArc(center, Point{r, r}, start+sweep*float64(i)/float64(n), sweep/float64(n))
+	}
+}
+
+// ellipseArc adds a cubic curve approximating an elliptical arc of at most 90 degrees.
+func (path *Path) ellipseArc(center, radius Point, start, sweep float64) {
+	k := 4.0 / 3.0 * math.Tan(sweep/4)
+
+	sin0, cos0 := math.Sincos(start)
+	sin1, cos1 := math.Sincos(start + sweep)
+
+	p0 := Point{center.X + cos0*radius.X, center.Y + sin0*radius.Y}
+	p1 := Point{center.X + cos1*radius.X, center.Y + sin1*radius.Y}
+	c0 := p0.Add(Point{-sin0 * radius.X * k, cos0 * radius.Y * k})
+	c1 := p1.Sub(Point{-sin1 * radius.X * k, cos1 * radius.Y * k})
+
+	path.CubicTo(c0, c1, p1)
+}
+
+// Close closes the current subpath.
+func (path *Path) Close() {
+	path.Segments = append(path.Segments, PathSegment{Op: PathClose})
+	path.pen = path.start
+}
+
+// Flatten approximates the path with polylines, one for each subpath,
+// such that the curves deviate from the lines by roughly tolerance.
+//
+// It can be used by canvases that only support polygons.
+func (path *Path) Flatten(tolerance Length) [][]Point {
+	if tolerance <= 0 {
+		tolerance = 0.25
+	}
+
+	var lines [][]Point
+	var line []Point
+	var pen, start Point
+
+	flush := func() {
+		if len(line) > 1 {
+			lines = append(lines, line)
+		}
+		line = nil
+	}
+
+	for _, segment := range path.Segments {
+		if segment.Op != PathMoveTo && len(line) == 0 {
+			line = append(line, pen)
+		}
+		switch segment.Op {
+		case PathMoveTo:
+			flush()
+			pen, start = segment.Points[0], segment.Points[0]
+			line = append(line, pen)
+		case PathLineTo:
+			line = append(line, segment.Points[0])
+		case PathQuadTo:
+			p0, c, p1 := pen, segment.Points[0], segment.Points[1]
+			n := curveSteps(tolerance, p0, c, p1)
+			for i := 1; i <= n; i++ {
+				t := float64(i) / float64(n)
+				u := 1 - t
+				line = append(line, Point{
+					u*u*p0.X + 2*u*t*c.X + t*t*p1.X,
+					u*u*p0.Y + 2*u*t*c.Y + t*t*p1.Y,
+				})
+			}
+		case PathCubicTo:
+			p0, c0, c1, p1 := pen, segment.Points[0], segment.Points[1], segment.Points[2]
+			n := curveSteps(tolerance, p0, c0, c1, p1)
+			for i := 1; i <= n; i++ {
+				t := float64(i) / float64(n)
+				u := 1 - t
+				line = append(line, Point{
+					u*u*u*p0.X + 3*u*u*t*c0.X + 3*u*t*t*c1.X + t*t*t*p1.X,
+					u*u*u*p0.Y + 3*u*u*t*c0.Y + 3*u*t*t*c1.Y + t*t*t*p1.Y,
+				})
+			}
+		case PathClose:
+			line = append(line, start)
+			flush()
+			pen = start
+			continue
+		}
+		pen = segment.End()
+	}
+	flush()
+
+	return lines
+}
+
+// curveSteps estimates the number of line segments needed to approximate
+// a curve with the given control polygon.
+func curveSteps(tolerance Length, points ...Point) int {
+	length := 0.0
+	for i := 1; i < len(points); i++ {
+		d := points[i].Sub(points[i-1])
+		length += math.Hypot(d.X, d.Y)
+	}
+	n := int(math.Ceil(math.Sqrt(length / tolerance)))
+	if n < 1 {
+		return 1
+	}
+	if n > 100 {
+		return 100
+	}
+	return n
+}
@@ -10,6 +10,10 @@ type Percentiles struct {
 	Style
 	Label string
 	Data  []Point
+	// Gaps determines how missing values are drawn.
+	Gaps GapMode
+
+	sorted sortedCache
 }
 
 // NewPercentiles creates percentiles from values.
@@ -45,6 +49,11 @@ func (line *Percentiles) Draw(plot *Plot, canvas Canvas) {
 	points := make([]Point, 0, len(line.Data))
 	lastScreenX := math.Inf(-1)
 	projectcb(line.Data, plot.X, plot.Y, canvas.Bounds(), func(p Point) {
+		if !isFinitePoint(p) {
+			points = append(points, p)
+			lastScreenX = math.Inf(-1)
+			return
+		}
 		if math.Abs(lastScreenX-p.X) > 0.5 {
 			points = append(points, p)
 			lastScreenX = p.X
@@ -52,12 +61,17 @@ func (line *Percentiles) Draw(plot *Plot, canvas Canvas) {
 	})
 
 	if !line.Style.IsZero() {
-		canvas.Poly(points, &line.Style)
+		line.Gaps.drawPolyline(canvas, points, &line.Style, &plot.Theme.Gap, nil, InterpolateLinear)
 	} else {
-		canvas.Poly(points, &plot.Theme.Line)
+		line.Gaps.drawPolyline(canvas, points, plot.autoStyle(line, &plot.Theme.Line), &plot.Theme.Gap, nil, InterpolateLinear)
 	}
 }
 
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (line *Percentiles) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	return hitTestPoints(line, line.Label, line.Data, &line.sorted, plot, bounds, at)
+}
+
 // PercentilesTransform implements axis transform for percentiles X axis.
 type PercentileTransform struct {
 	levels  int
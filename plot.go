@@ -1,13 +1,28 @@
 package plot
 
+import "math"
+
 // Plot defines a combination of elements that can be drawn to the canvas.
 type Plot struct {
 	// X, Y are the axis information
-	X, Y   *Axis
+	X, Y *Axis
+	// Polar, when not nil, maps X to the angle and Y to the radius
+	// for the elements that support it.
+	Polar  *Polar
 	Margin Rect
+	// Padding is the space around the plot area in addition to the overflow, see Layout.
+	Padding Rect
+
+	// Title and Subtitle are drawn above the plot area and Caption below it.
+	Title    string
+	Subtitle string
+	Caption  string
+
 	Elements
 	// DefaultStyle
 	Theme
+
+	palette *paletteAssignment
 }
 
 // Element is a drawable plot element.
@@ -34,29 +49,76 @@ func New() *Plot {
 	}
 }
 
+// Update updates the axis values using element stats.
+func (plot *Plot) Update() {
+	tx, ty := detectAxis(plot.X, plot.Y, plot.Elements)
+	*plot.X = *tx
+	*plot.Y = *ty
+}
+
 // Draw draws plot to the specified canvas, creating axes automatically when necessary.
 func (plot *Plot) Draw(canvas Canvas) {
+	// the palette assignment belongs to a single drawing,
+	// such that the plot can be drawn concurrently
+	tmpplot := &Plot{}
+	*tmpplot = *plot
+	plot = tmpplot
+	plot.palette = &paletteAssignment{assigned: map[interface{}]int{}}
 	if !plot.X.IsValid() || !plot.Y.IsValid() {
-		tmpplot := &Plot{}
-		*tmpplot = *plot
-		plot = tmpplot
 		plot.X, plot.Y = detectAxis(plot.X, plot.Y, plot.Elements)
 	}
 
+	if themed, ok := canvas.(ThemedCanvas); ok {
+		themed.SetTheme(&plot.Theme)
+	}
+	if described, ok := canvas.(DescribedCanvas); ok {
+		described.SetDescription(plot.Title, plot.description())
+	}
+
 	bounds := canvas.Bounds()
-	if !plot.Margin.Empty() {
-		bounds = bounds.Inset(plot.Margin)
+	if plot.Theme.Background != nil {
+		canvas.Rect(bounds, &Style{
+			Fill:  plot.Theme.Background,
+			Class: "background",
+		})
 	}
+	bounds = plot.area(textMeasurer(canvas), bounds)
+	plot.drawTitles(canvas, bounds)
 
 	for _, element := range plot.Elements {
 		element.Draw(plot, canvas.Context(bounds))
 	}
 }
 
+// Area returns the plot area inside canvas, where the elements are drawn.
+func (plot *Plot) Area(canvas Canvas) Rect {
+	return plot.area(textMeasurer(canvas), canvas.Bounds())
+}
+
+// HitTest finds the nearest data item to at, when the plot is drawn to canvas.
+func (plot *Plot) HitTest(canvas Canvas, at Point) (Hit, bool) {
+	if !plot.X.IsValid() || !plot.Y.IsValid() {
+		tmpplot := &Plot{}
+		*tmpplot = *plot
+		plot = tmpplot
+		plot.X, plot.Y = detectAxis(plot.X, plot.Y, plot.Elements)
+	}
+
+	return hitTestElements(plot, plot.Elements, plot.Area(canvas), at)
+}
+
 // AxisGroup allows sub-elements to have different different axes defined rather than the top-level plot.
+//
+// When X or Y is nil, the axis is shared with the parent plot.
 type AxisGroup struct {
 	X, Y *Axis
 	Elements
+
+	// Top and Right bind the group axes to the opposite sides of the plot area,
+	// which is used by the tick labels and decorations created by the group.
+	Top, Right bool
+	// AlignTicks adjusts the group axes such that their major ticks align with the parent plot.
+	AlignTicks bool
 }
 
 // NewAxisGroup creates a new axis group.
@@ -69,15 +131,61 @@ func NewAxisGroup(els ...Element) *AxisGroup {
 	}
 }
 
-// Update updates the axis values.
+// NewTwinX creates an axis group with a separate X axis at the top side,
+// sharing the Y axis with the parent plot.
+func NewTwinX(els ...Element) *AxisGroup {
+	return &AxisGroup{
+		X:        NewAxis(),
+		Elements: Elements(els),
+		Top:      true,
+	}
+}
+
+// NewTwinY creates an axis group with a separate Y axis at the right side,
+// sharing the X axis with the parent plot.
+func NewTwinY(els ...Element) *AxisGroup {
+	y := NewAxis()
+	y.Flip = true
+	return &AxisGroup{
+		Y:        y,
+		Elements: Elements(els),
+		Right:    true,
+	}
+}
+
+// Stats calculates the stats for the axes shared with the parent plot.
+func (group *AxisGroup) Stats() Stats {
+	stats := group.Elements.Stats()
+	if group.X != nil {
+		stats.Min.X, stats.Center.X, stats.Max.X = math.NaN(), math.NaN(), math.NaN()
+	}
+	if group.Y != nil {
+		stats.Min.Y, stats.Center.Y, stats.Max.Y = math.NaN(), math.NaN(), math.NaN()
+	}
+	return stats
+}
+
+// Update updates the axis values, the axes shared with the parent plot are left unchanged.
 func (group *AxisGroup) Update() {
-	tx, ty := detectAxis(group.X, group.Y, group.Elements)
-	*group.X = *tx
-	*group.Y = *ty
+	x, y := group.X, group.Y
+	if x == nil {
+		x = NewAxis()
+	}
+	if y == nil {
+		y = NewAxis()
+	}
+
+	tx, ty := detectAxis(x, y, group.Elements)
+	if group.X != nil {
+		*group.X = *tx
+	}
+	if group.Y != nil {
+		*group.Y = *ty
+	}
 }
 
-// Draw draws elements bound to this axis-group creating an axis automatically if necessary.
-func (group *AxisGroup) Draw(plot *Plot, canvas Canvas) {
+// plot returns a plot using the axes of this group.
+func (group *AxisGroup) plot(plot *Plot) *Plot {
 	tmpplot := &Plot{}
 	*tmpplot = *plot
 
@@ -92,7 +200,95 @@ func (group *AxisGroup) Draw(plot *Plot, canvas Canvas) {
 		tmpplot.X, tmpplot.Y = detectAxis(tmpplot.X, tmpplot.Y, group.Elements)
 	}
 
+	if group.AlignTicks {
+		if group.X != nil {
+			x := *tmpplot.X
+			alignAxis(&x, plot.X)
+			tmpplot.X = &x
+		}
+		if group.Y != nil {
+			y := *tmpplot.Y
+			alignAxis(&y, plot.Y)
+			tmpplot.Y = &y
+		}
+	}
+
+	return tmpplot
+}
+
+// Draw draws elements bound to this axis-group creating an axis automatically if necessary.
+func (group *AxisGroup) Draw(plot *Plot, canvas Canvas) {
+	tmpplot := group.plot(plot)
 	for _, element := range group.Elements {
 		element.Draw(tmpplot, canvas.Context(canvas.Bounds()))
 	}
 }
+
+// Overflow calculates how much the element extends beyond bounds on each side.
+func (group *AxisGroup) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	return elementOverflow(group.plot(plot), measurer, group.Elements, bounds)
+}
+
+// Layout updates the margins of the children such that they fit into bounds.
+func (group *AxisGroup) Layout(plot *Plot, measurer TextMeasurer, bounds Rect) {
+	layoutElement(group.plot(plot), measurer, group.Elements, bounds)
+}
+
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (group *AxisGroup) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	return group.Elements.HitTest(group.plot(plot), bounds, at)
+}
+
+// Bind returns an element that is drawn using the axes of this group,
+// such as tick labels for the group axes.
+func (group *AxisGroup) Bind(el Element) Element {
+	return &boundElement{group: group, el: el}
+}
+
+// TickLabels creates tick labels for the separate axes of the group.
+func (group *AxisGroup) TickLabels() Element {
+	labels := NewTickLabels()
+	labels.X.Enabled = group.X != nil
+	labels.Y.Enabled = group.Y != nil
+	if group.Top {
+		labels.X.Side = 1
+	}
+	if group.Right {
+		labels.Y.Side = 1
+	}
+	return group.Bind(labels)
+}
+
+// XAxisDecoration creates a decoration for the X axis of the group.
+func (group *AxisGroup) XAxisDecoration(title, unit string) Element {
+	side := SideBottom
+	if group.Top {
+		side = SideTop
+	}
+	return group.Bind(NewAxisDecoration(side, title, unit))
+}
+
+// YAxisDecoration creates a decoration for the Y axis of the group.
+func (group *AxisGroup) YAxisDecoration(title, unit string) Element {
+	side := SideLeft
+	if group.Right {
+		side = SideRight
+	}
+	return group.Bind(NewAxisDecoration(side, title, unit))
+}
+
+// boundElement draws an element using the axes of a group.
+type boundElement struct {
+	group *AxisGroup
+	el    Element
+}
+
+// Draw draws the element using the group axes.
+func (bound *boundElement) Draw(plot *Plot, canvas Canvas) {
+	bound.el.Draw(bound.group.plot(plot), canvas)
+}
+
+// Overflow calculates how much the element extends beyond bounds on each side.
+func (bound *boundElement) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	return elementOverflow(bound.group.plot(plot), measurer, bound.el, bounds)
+}
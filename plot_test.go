@@ -0,0 +1,144 @@
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTwinUpdate(t *testing.T) {
+	line := NewLine("", []Point{{1, 10}, {9, 90}})
+
+	twinX := NewTwinX(line)
+	twinX.Update()
+	if twinX.Y != nil {
+		t.Errorf("twin X: shared Y axis was created")
+	}
+	if !twinX.X.IsValid() || twinX.X.Min > 1 || twinX.X.Max < 9 {
+		t.Errorf("twin X: got range %v..%v", twinX.X.Min, twinX.X.Max)
+	}
+
+	twinY := NewTwinY(line)
+	twinY.Update()
+	if twinY.X != nil {
+		t.Errorf("twin Y: shared X axis was created")
+	}
+	if !twinY.Y.IsValid() || twinY.Y.Min > 10 || twinY.Y.Max < 90 {
+		t.Errorf("twin Y: got range %v..%v", twinY.Y.Min, twinY.Y.Max)
+	}
+}
+
+func TestDrawConcurrently(t *testing.T) {
+	p := New()
+	p.Theme.Palette = Tableau10
+	p.Add(NewLine("a", []Point{{0, 0}, {1, 1}}))
+	p.Add(NewLine("b", []Point{{0, 1}, {1, 0}}))
+
+	done := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			p.Draw(&polyCanvas{bounds: R(0, 0, 100, 100)})
+		}()
+	}
+	<-done
+	<-done
+
+	if p.palette != nil {
+		t.Errorf("drawing modified the plot palette")
+	}
+}
+
+func TestAlignAxis(t *testing.T) {
+	tests := []struct {
+		min, max  float64
+		intervals int
+	}{
+		{0, 97, 5},
+		{-3, 42, 5},
+		{0.013, 0.087, 4},
+		{1000, 1003, 5},
+		{-250, -20, 3},
+		{5, 5, 5},
+	}
+
+	for _, test := range tests {
+		primary := NewAxis()
+		primary.MajorTicks = test.intervals
+		secondary := NewAxis()
+		secondary.Min, secondary.Max = test.min, test.max
+		alignAxis(secondary, primary)
+
+		if test.min == test.max {
+			if secondary.Min != test.min || secondary.Max != test.max {
+				t.Errorf("%v..%v: empty range was changed to %v..%v", test.min, test.max, secondary.Min, secondary.Max)
+			}
+			continue
+		}
+		if secondary.Min > test.min || secondary.Max < test.max {
+			t.Errorf("%v..%v: got %v..%v, which doesn't contain the range", test.min, test.max, secondary.Min, secondary.Max)
+		}
+		if secondary.MajorTicks != test.intervals {
+			t.Errorf("%v..%v: got %d major ticks, expected %d", test.min, test.max, secondary.MajorTicks, test.intervals)
+		}
+		step := (secondary.Max - secondary.Min) / float64(test.intervals)
+		if nice := niceNumber(step, false); math.Abs(nice-step) > 1e-9*step {
+			t.Errorf("%v..%v: got step %v, expected a nice number", test.min, test.max, step)
+		}
+		if ticks := secondary.Min / step; math.Abs(ticks-math.Round(ticks)) > 1e-6 {
+			t.Errorf("%v..%v: got min %v, expected a multiple of %v", test.min, test.max, secondary.Min, step)
+		}
+	}
+}
+
+// axisRecorder records the axes used for drawing.
+type axisRecorder struct{ x, y *Axis }
+
+func (rec *axisRecorder) Draw(plot *Plot, canvas Canvas) { rec.x, rec.y = plot.X, plot.Y }
+
+func TestAxisGroupBind(t *testing.T) {
+	tests := []struct {
+		name  string
+		group *AxisGroup
+		align bool
+	}{
+		{"twin x", NewTwinX(), false},
+		{"twin y", NewTwinY(), false},
+		{"aligned twin y", NewTwinY(), true},
+		{"both", NewAxisGroup(), false},
+	}
+
+	for _, test := range tests {
+		p := New()
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 0, 100
+		group := test.group
+		group.AlignTicks = test.align
+		for _, axis := range []*Axis{group.X, group.Y} {
+			if axis != nil {
+				axis.Min, axis.Max = 3, 47
+			}
+		}
+
+		rec := &axisRecorder{}
+		group.Bind(rec).Draw(p, &polyCanvas{bounds: R(0, 0, 100, 100)})
+
+		check := func(name string, got, own, parent *Axis) {
+			switch {
+			case own == nil:
+				if got != parent {
+					t.Errorf("%s: %s axis isn't shared with the parent", test.name, name)
+				}
+			case test.align:
+				if got == own || got.Min > own.Min || got.Max < own.Max || got.MajorTicks != parent.MajorTicks {
+					t.Errorf("%s: %s axis %v..%v isn't aligned", test.name, name, got.Min, got.Max)
+				}
+			default:
+				if got != own {
+					t.Errorf("%s: %s axis isn't the group axis", test.name, name)
+				}
+			}
+		}
+		check("x", rec.x, group.X, p.X)
+		check("y", rec.y, group.Y, p.Y)
+	}
+}
@@ -1,6 +1,7 @@
 package plotgio
 
 import (
+	"image"
 	"image/color"
 	"sort"
 
@@ -10,24 +11,32 @@ import (
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
 	"gioui.org/text"
-	"gioui.org/unit"
-	"gioui.org/widget"
 	"gioui.org/x/stroke"
 	"github.com/loov/plot"
 )
 
-var _ plot.Canvas = (*Canvas)(nil)
+var (
+	_ plot.PathCanvas    = (*Canvas)(nil)
+	_ plot.OpacityCanvas = (*Canvas)(nil)
+	_ plot.ImageCanvas   = (*Canvas)(nil)
+)
 
 // Canvas describes the top-level ptx drawing context.
 type Canvas struct {
 	Shaper *text.Shaper
+	// Images keeps the converted images between frames, when set.
+	Images *ImageCache
 
 	context
+
+	// alpha is the group opacity while adding layers.
+	alpha float32
 }
 
 // New creates a new SVG canvas.
 func New(shaper *text.Shaper, size f32.Point) *Canvas {
 	ptx := &Canvas{Shaper: shaper}
+	ptx.shaper = shaper
 	ptx.bounds.Max.X = plot.Length(size.X)
 	ptx.bounds.Max.Y = plot.Length(size.Y)
 	return ptx
@@ -35,8 +44,13 @@ func New(shaper *text.Shaper, size f32.Point) *Canvas {
 
 // context describes a ptx drawing context.
 type context struct {
+	shaper *text.Shaper
+
 	index int
 	clip  bool
+	// opacity is applied to the whole context, when grouped is set
+	grouped bool
+	opacity float64
 	// bounds relative to parent
 	bounds   plot.Rect
 	elements []element
@@ -49,6 +63,11 @@ type element struct {
 	style plot.Style
 	// line
 	points []plot.Point
+	// path, circles and ellipses are converted to paths
+	path *plot.Path
+	// image
+	image image.Image
+	dst   plot.Rect
 	// text
 	text   string
 	origin plot.Point
@@ -70,6 +89,7 @@ func (ptx *context) Size() plot.Point {
 func (ptx *context) context(r plot.Rect, clip bool) plot.Canvas {
 	element := element{}
 	element.context = &context{}
+	element.context.shaper = ptx.shaper
 	element.context.clip = clip
 	element.context.bounds = r
 	ptx.elements = append(ptx.elements, element)
@@ -86,6 +106,22 @@ func (ptx *context) Clip(r plot.Rect) plot.Canvas {
 	return ptx.context(r, true)
 }
 
+// Opacity returns a context that is composited with alpha.
+//
+// The Gio version used here has no group opacity, so the alpha is
+// multiplied into the colors of the content instead. Hence overlapping
+// shapes within the context are still visible through each other.
+func (ptx *context) Opacity(alpha float64) plot.Canvas {
+	element := element{}
+	element.context = &context{}
+	element.context.shaper = ptx.shaper
+	element.context.bounds = ptx.Bounds()
+	element.context.grouped = true
+	element.context.opacity = alpha
+	ptx.elements = append(ptx.elements, element)
+	return element.context
+}
+
 // Layer returns an layer above or below current state.
 func (ptx *context) Layer(index int) plot.Canvas {
 	if index == 0 {
@@ -99,6 +135,7 @@ func (ptx *context) Layer(index int) plot.Canvas {
 		return ptx.layers[i]
 	} else {
 		layer := &context{}
+		layer.shaper = ptx.shaper
 		layer.index = index
 		layer.bounds = ptx.bounds.Zero()
 
@@ -109,6 +146,14 @@ func (ptx *context) Layer(index int) plot.Canvas {
 	}
 }
 
+// MeasureText returns the bounds of text drawn at (0, 0).
+func (ptx *context) MeasureText(text string, style *plot.Style) plot.Rect {
+	shaped := layoutText(ptx.shaper, text, style)
+	size := shaped.bounds.Size()
+	ascent := shaped.baseline - shaped.bounds.Min.Y
+	return plot.TextBounds(size.X, ascent, size.Y-ascent, style)
+}
+
 // Text draws text.
 func (ptx *context) Text(text string, at plot.Point, style *plot.Style) {
 	mustExist(style)
@@ -133,12 +178,55 @@ func (ptx *context) Rect(r plot.Rect, style *plot.Style) {
 	ptx.Poly(r.Points(), style)
 }
 
+// Path draws a path.
+func (ptx *context) Path(path *plot.Path, style *plot.Style) {
+	mustExist(style)
+	ptx.elements = append(ptx.elements, element{
+		path:  path,
+		style: *style,
+	})
+}
+
+// Circle draws a circle.
+func (ptx *context) Circle(center plot.Point, radius plot.Length, style *plot.Style) {
+	ptx.Ellipse(center, plot.Point{X: radius, Y: radius}, style)
+}
+
+// Ellipse draws an ellipse.
+func (ptx *context) Ellipse(center, radius plot.Point, style *plot.Style) {
+	ptx.Path(plot.NewEllipsePath(center, radius), style)
+}
+
+// Image draws an image.
+func (ptx *context) Image(img image.Image, dst plot.Rect, style *plot.Style) {
+	mustExist(style)
+	ptx.elements = append(ptx.elements, element{
+		image: img,
+		dst:   dst,
+		style: *style,
+	})
+}
+
 // Layout renders plot to gtx.
 func (c *Canvas) Add(gtx layout.Context) {
+	c.alpha = 1
 	c.addLayer(&c.context, gtx)
+	c.Images.sweep()
+}
+
+// color converts col applying the group opacity.
+func (c *Canvas) color(col color.Color) color.NRGBA {
+	n := convertColor(col)
+	n.A = uint8(float32(n.A)*c.alpha + 0.5)
+	return n
 }
 
 func (c *Canvas) addLayer(ptx *context, gtx layout.Context) {
+	if ptx.grouped {
+		alpha := c.alpha
+		c.alpha *= float32(ptx.opacity)
+		defer func() { c.alpha = alpha }()
+	}
 	if !ptx.bounds.Min.Empty() {
 		defer op.Affine(f32.Affine2D{}.Offset(pt(ptx.bounds.Min))).Push(gtx.Ops).Pop()
 	}
@@ -170,6 +258,12 @@ func (c *Canvas) addElement(el *element, gtx layout.Context) {
 	if len(el.points) > 0 {
 		c.addShape(el, gtx)
 	}
+	if el.path != nil && len(el.path.Segments) > 0 {
+		c.addCurve(el, gtx)
+	}
+	if el.image != nil {
+		c.addImage(el, gtx)
+	}
 	if el.text != "" {
 		c.addText(el, gtx)
 	}
@@ -183,27 +277,33 @@ func (c *Canvas) addShape(el *element, gtx layout.Context) {
 	if len(el.points) == 0 {
 		return
 	}
-	if style.Size == 0 && style.Stroke == nil && style.Fill == nil && len(style.Dash) == 0 {
+	// invisible shapes, such as the hover targets of plot.Tooltips, don't need any ops
+	if style.FillGradient == nil && transparent(style.Fill) && (style.Size <= 0 || transparent(style.Stroke)) {
 		return
 	}
 
-	if style.Fill != nil {
+	if style.FillGradient != nil {
+		c.fillGradient(gtx, style.FillGradient, plot.PointsBounds(el.points), clip.Outline{
+			Path: el.addPath(gtx),
+		}.Op())
+	} else if style.Fill != nil {
 		paint.FillShape(gtx.Ops,
-			convertColor(style.Fill),
+			c.color(style.Fill),
 			clip.Outline{
 				Path: el.addPath(gtx),
 			}.Op())
 	}
 
 	if style.Stroke != nil && style.Size > 0 {
-		// TODO: support dashes
 		paint.FillShape(gtx.Ops,
-			convertColor(style.Stroke),
+			c.color(style.Stroke),
 			stroke.Stroke{
-				Path:  el.strokePath(),
-				Width: float32(style.Size), // TODO: should this be dp or sp or px?
-				Cap:   stroke.FlatCap,
-				Join:  stroke.RoundJoin,
+				Path:   el.strokePath(),
+				Width:  float32(style.Size), // TODO: should this be dp or sp or px?
+				Miter:  miterLimit,
+				Cap:    convertCap(style.Cap),
+				Join:   convertJoin(style.Join),
+				Dashes: convertDashes(style),
 			}.Op(gtx.Ops),
 		)
 	}
@@ -229,22 +329,86 @@ func (el *element) strokePath() stroke.Path {
 	return path
 }
 
-func (c *Canvas) addText(el *element, gtx layout.Context) {
+func (c *Canvas) addCurve(el *element, gtx layout.Context) {
 	style := &el.style
-	if style.Font == "" && style.Size == 0 && style.Stroke == nil && style.Fill == nil {
+	// invisible shapes, such as the hover targets of plot.Tooltips, don't need any ops
+	if style.FillGradient == nil && transparent(style.Fill) && (style.Size <= 0 || transparent(style.Stroke)) {
 		return
 	}
-	defer op.Offset(pt(el.origin).Round()).Push(gtx.Ops).Pop()
 
-	// TODO: style.Rotation
-	// TODO: style.Origin
-	// TODO: style.Font
-	// TODO: style.Stroke
+	if style.FillGradient != nil {
+		bounds := pathBounds(el.path)
+		c.fillGradient(gtx, style.FillGradient, bounds, clip.Outline{
+			Path: el.addCurvePath(gtx),
+		}.Op())
+	} else if style.Fill != nil {
+		paint.FillShape(gtx.Ops,
+			c.color(style.Fill),
+			clip.Outline{
+				Path: el.addCurvePath(gtx),
+			}.Op())
+	}
 
-	if style.Fill != nil {
-		paint.ColorOp{Color: convertColor(style.Fill)}.Add(gtx.Ops)
-	} else {
-		paint.ColorOp{Color: convertColor(color.Black)}.Add(gtx.Ops)
+	if style.Stroke != nil && style.Size > 0 {
+		paint.FillShape(gtx.Ops,
+			c.color(style.Stroke),
+			stroke.Stroke{
+				Path:   el.strokeCurvePath(),
+				Width:  float32(style.Size),
+				Miter:  miterLimit,
+				Cap:    convertCap(style.Cap),
+				Join:   convertJoin(style.Join),
+				Dashes: convertDashes(style),
+			}.Op(gtx.Ops),
+		)
 	}
-	widget.Label{}.Layout(gtx, c.Shaper, text.Font{}, unit.Sp(style.Size), el.text)
+}
+
+func (el *element) addCurvePath(gtx layout.Context) clip.PathSpec {
+	return curvePath(gtx.Ops, el.path)
+}
+
+// curvePath converts path to a clip path.
+func curvePath(ops *op.Ops, src *plot.Path) clip.PathSpec {
+	path := &clip.Path{}
+	path.Begin(ops)
+	for _, segment := range src.Segments {
+		p := segment.Points
+		switch segment.Op {
+		case plot.PathMoveTo:
+			path.MoveTo(pt(p[0]))
+		case plot.PathLineTo:
+			path.LineTo(pt(p[0]))
+		case plot.PathQuadTo:
+			path.QuadTo(pt(p[0]), pt(p[1]))
+		case plot.PathCubicTo:
+			path.CubeTo(pt(p[0]), pt(p[1]), pt(p[2]))
+		case plot.PathClose:
+			path.Close()
+		}
+	}
+	return path.End()
+}
+
+func (el *element) strokeCurvePath() stroke.Path {
+	var path stroke.Path
+	var start plot.Point
+	path.Segments = make([]stroke.Segment, 0, len(el.path.Segments))
+	for _, segment := range el.path.Segments {
+		p := segment.Points
+		switch segment.Op {
+		case plot.PathMoveTo:
+			start = p[0]
+			path.Segments = append(path.Segments, stroke.MoveTo(pt(p[0])))
+		case plot.PathLineTo:
+			path.Segments = append(path.Segments, stroke.LineTo(pt(p[0])))
+		case plot.PathQuadTo:
+			path.Segments = append(path.Segments, stroke.QuadTo(pt(p[0]), pt(p[1])))
+		case plot.PathCubicTo:
+			path.Segments = append(path.Segments, stroke.CubeTo(pt(p[0]), pt(p[1]), pt(p[2])))
+		case plot.PathClose:
+			path.Segments = append(path.Segments, stroke.LineTo(pt(start)))
+		}
+	}
+	return path
 }
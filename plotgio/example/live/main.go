@@ -35,42 +35,43 @@ func loop(w *app.Window) error {
 
 	dataset := &Dataset{
 		Shaper: shaper,
+		Values: plot.NewStream("Red", 1024),
 	}
+	dataset.Values.Window = 5
 
-	tick := time.NewTicker(30 * time.Millisecond)
+	go dataset.Produce(w)
 
 	var ops op.Ops
 	for {
-		select {
-		case e := <-w.Events():
-			switch e := e.(type) {
-			case system.DestroyEvent:
-				return e.Err
-			case system.FrameEvent:
-				gtx := layout.NewContext(&ops, e)
-
-				dataset.Layout(gtx)
-
-				e.Frame(gtx.Ops)
-			}
-		case <-tick.C:
-			dataset.AddRandomValue()
-			w.Invalidate()
+		e := <-w.Events()
+		switch e := e.(type) {
+		case system.DestroyEvent:
+			return e.Err
+		case system.FrameEvent:
+			gtx := layout.NewContext(&ops, e)
+
+			dataset.Layout(gtx)
+
+			e.Frame(gtx.Ops)
 		}
 	}
 }
 
 type Dataset struct {
 	Shaper *text.Shaper
-	Values [200]float64
-
-	points []plot.Point
+	Values *plot.Stream
 }
 
-func (display *Dataset) AddRandomValue() {
-	p := display.Values[len(display.Values)-1]
-	copy(display.Values[:], display.Values[1:])
-	display.Values[len(display.Values)-1] = 0.8*p + 0.2*rand.Float64()*50
+func (display *Dataset) Produce(w *app.Window) {
+	tick := time.NewTicker(30 * time.Millisecond)
+	defer tick.Stop()
+
+	p := 0.0
+	for now := range tick.C {
+		p = 0.8*p + 0.2*rand.Float64()*50
+		display.Values.AddTime(now, p)
+		w.Invalidate()
+	}
 }
 
 func (display *Dataset) Layout(gtx layout.Context) layout.Dimensions {
@@ -91,21 +92,23 @@ func (display *Dataset) layoutGraph(gtx layout.Context, size f32.Point) {
 	stack.Margin = defaultMargin
 	p.Add(stack)
 
-	if len(display.points) != len(display.Values) {
-		display.points = make([]plot.Point, len(display.Values))
-	}
-	for i, v := range display.Values {
-		display.points[i] = plot.P(float64(i), v)
-	}
-
-	line := plot.NewLine("Red", display.points)
+	line := display.Values
 	line.Size = 2
 	line.Stroke = color.NRGBA{200, 0, 0, 255}
+	line.Join = plot.RoundJoin
+
+	stats := line.Stats()
+	average := stats.Center.Y
+	reference := plot.NewLine("Average", plot.Ps(stats.Min.X, average, stats.Max.X, average))
+	reference.Size = 1
+	reference.Stroke = color.NRGBA{0, 0, 0, 255}
+	reference.Dash = []plot.Length{4, 2}
 
 	stack.AddGroup(
 		plot.NewGrid(),
 		plot.NewGizmo(),
 		line,
+		reference,
 		plot.NewTickLabels(),
 		plot.NewXLabel("Random Data"),
 	)
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+
+	"gioui.org/app"
+	"gioui.org/font/gofont"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"github.com/loov/plot"
+
+	"github.com/loov/plot/plotgio"
+)
+
+func main() {
+	go func() {
+		w := app.NewWindow(app.Size(800, 600))
+		if err := loop(w); err != nil {
+			log.Fatal(err)
+		}
+		os.Exit(0)
+	}()
+	app.Main()
+}
+
+func loop(w *app.Window) error {
+	shaper := text.NewShaper(gofont.Collection())
+
+	const N = 1 << 12
+	xs := make([]float64, N)
+	sine := make([]float64, N)
+	noise := make([]float64, N)
+	for i := range xs {
+		xs[i] = float64(i) * 0.01
+		sine[i] = math.Sin(xs[i])
+		noise[i] = sine[i] + rand.Float64()*0.2 - 0.1
+	}
+
+	top := plotgio.NewPlot(shaper, newPlot(plot.Points(xs, sine), color.NRGBA{200, 0, 0, 255}))
+	bottom := plotgio.NewPlot(shaper, newPlot(plot.Points(xs, noise), color.NRGBA{0, 0, 200, 255}))
+	bottom.LockY = true
+	top.AutoMargin = true
+	bottom.AutoMargin = true
+	plotgio.LinkX(top, bottom)
+
+	var ops op.Ops
+	for {
+		e := <-w.Events()
+		switch e := e.(type) {
+		case system.DestroyEvent:
+			return e.Err
+		case system.FrameEvent:
+			gtx := layout.NewContext(&ops, e)
+
+			layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Flexed(1, top.Layout),
+				layout.Flexed(1, bottom.Layout),
+			)
+
+			e.Frame(gtx.Ops)
+		}
+	}
+}
+
+func newPlot(points []plot.Point, stroke color.Color) *plot.Plot {
+	p := plot.New()
+	p.Padding = plot.R(8, 8, 8, 8)
+
+	line := plot.NewLine("", points)
+	line.Size = 1
+	line.Stroke = stroke
+
+	p.AddGroup(
+		plot.NewGrid(),
+		plot.NewGizmo(),
+		line,
+		plot.NewTickLabels(),
+	)
+	return p
+}
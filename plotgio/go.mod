@@ -5,7 +5,8 @@ go 1.19
 require (
 	gioui.org v0.0.0-20230101161950-e9bce02b24f0
 	gioui.org/x v0.0.0-20221219202300-e2d994f107e4
-	github.com/loov/plot v0.0.0-20201015163730-aba1182a13ce
+	github.com/loov/plot v0.0.0-00010101000000-000000000000
+	golang.org/x/image v0.0.0-20220722155232-062f8c9fd539
 )
 
 require (
@@ -16,7 +17,9 @@ require (
 	github.com/go-text/typesetting v0.0.0-20221214153724-0399769901d5 // indirect
 	golang.org/x/exp v0.0.0-20221012211006-4de253d81b95 // indirect
 	golang.org/x/exp/shiny v0.0.0-20220827204233-334a2380cb91 // indirect
-	golang.org/x/image v0.0.0-20220722155232-062f8c9fd539 // indirect
 	golang.org/x/sys v0.0.0-20220825204002-c680a09ffe64 // indirect
 	golang.org/x/text v0.3.7 // indirect
 )
+
+// plotgio is developed together with plot in this repository.
+replace github.com/loov/plot => ../
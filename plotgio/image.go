@@ -0,0 +1,169 @@
+package plotgio
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"reflect"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"github.com/loov/plot"
+)
+
+// maxNearestSize is the largest size of an image upscaled for nearest sampling.
+const maxNearestSize = 4096
+
+// ImageCache keeps converted images between frames,
+// such that unchanged images aren't converted and uploaded again.
+//
+// Images are identified by their pointer, hence an image must be
+// replaced rather than modified in place.
+type ImageCache struct {
+	entries map[imageKey]*imageEntry
+}
+
+// imageKey identifies a converted image.
+type imageKey struct {
+	image    image.Image
+	size     image.Point
+	sampling plot.Sampling
+	alpha    uint8
+}
+
+// imageEntry is a converted image.
+type imageEntry struct {
+	op   paint.ImageOp
+	used bool
+}
+
+// get returns the cached image op for key or creates it.
+func (cache *ImageCache) get(key imageKey, create func() paint.ImageOp) paint.ImageOp {
+	// only pointers reliably identify an image and other types may not be comparable
+	if cache == nil || reflect.ValueOf(key.image).Kind() != reflect.Ptr {
+		return create()
+	}
+	if cache.entries == nil {
+		cache.entries = map[imageKey]*imageEntry{}
+	}
+
+	entry, ok := cache.entries[key]
+	if !ok {
+		entry = &imageEntry{op: create()}
+		cache.entries[key] = entry
+	}
+	entry.used = true
+	return entry.op
+}
+
+// sweep removes the images that haven't been used since the previous sweep.
+func (cache *ImageCache) sweep() {
+	if cache == nil {
+		return
+	}
+	for key, entry := range cache.entries {
+		if !entry.used {
+			delete(cache.entries, key)
+			continue
+		}
+		entry.used = false
+	}
+}
+
+// addImage paints the image scaled to the destination.
+func (c *Canvas) addImage(el *element, gtx layout.Context) {
+	src := el.image
+	size := el.dst.Size()
+	if src.Bounds().Empty() || size.X <= 0 || size.Y <= 0 {
+		return
+	}
+
+	key := imageKey{
+		image:    src,
+		size:     image.Point{X: int(math.Ceil(size.X)), Y: int(math.Ceil(size.Y))},
+		sampling: el.style.Sampling,
+		alpha:    uint8(c.alpha*0xff + 0.5),
+	}
+	imageOp := c.Images.get(key, func() paint.ImageOp {
+		// ImageOp always samples linearly, hence nearest sampling is
+		// emulated by upscaling the image before uploading it.
+		if key.sampling == plot.SampleNearest {
+			src = upscaleNearest(src, size)
+		}
+		if key.alpha < 0xff {
+			src = fadeImage(src, key.alpha)
+		}
+		return paint.NewImageOp(src)
+	})
+	imageSize := imageOp.Size()
+
+	defer pushClipRect(el.dst, gtx.Ops).Pop()
+	scale := f32.Point{
+		X: float32(size.X) / float32(imageSize.X),
+		Y: float32(size.Y) / float32(imageSize.Y),
+	}
+	defer op.Affine(f32.Affine2D{}.Scale(f32.Point{}, scale).Offset(pt(el.dst.Min))).Push(gtx.Ops).Pop()
+
+	imageOp.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+}
+
+// upscaleNearest scales src by an integer factor, such that it's close to size.
+func upscaleNearest(src image.Image, size plot.Point) image.Image {
+	bounds := src.Bounds()
+	factor := func(target float64, length int) int {
+		n := int(math.Floor(target / float64(length)))
+		if n*length > maxNearestSize {
+			n = maxNearestSize / length
+		}
+		if n < 1 {
+			return 1
+		}
+		return n
+	}
+	fx, fy := factor(size.X, bounds.Dx()), factor(size.Y, bounds.Dy())
+	if fx == 1 && fy == 1 {
+		return src
+	}
+
+	rgba := toRGBA(src)
+	width, height := bounds.Dx(), bounds.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width*fx, height*fy))
+	for y := 0; y < height; y++ {
+		srcRow := rgba.Pix[y*rgba.Stride : y*rgba.Stride+width*4]
+		dstRow := dst.Pix[y*fy*dst.Stride : y*fy*dst.Stride+width*fx*4]
+		for x := 0; x < width; x++ {
+			pixel := srcRow[x*4 : x*4+4]
+			for k := 0; k < fx; k++ {
+				copy(dstRow[(x*fx+k)*4:], pixel)
+			}
+		}
+		for k := 1; k < fy; k++ {
+			copy(dst.Pix[(y*fy+k)*dst.Stride:], dstRow)
+		}
+	}
+	return dst
+}
+
+// toRGBA converts src to an RGBA image starting at zero.
+func toRGBA(src image.Image) *image.RGBA {
+	if rgba, ok := src.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	return rgba
+}
+
+// fadeImage multiplies the alpha of src.
+func fadeImage(src image.Image, alpha uint8) image.Image {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.DrawMask(dst, dst.Bounds(), src, bounds.Min,
+		image.NewUniform(color.Alpha{A: alpha}), image.Point{}, draw.Src)
+	return dst
+}
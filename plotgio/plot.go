@@ -0,0 +1,296 @@
+package plotgio
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/text"
+	"github.com/loov/plot"
+)
+
+// doubleClickDuration is the maximum duration between two presses to reset the view.
+const doubleClickDuration = 300 * time.Millisecond
+
+// Plot is a widget that draws a plot and allows panning and zooming it.
+//
+// Dragging pans the plot, scrolling or pinching zooms and double-click
+// resets the axes to the initial state. Hovering shows a tooltip
+// for the nearest data item.
+type Plot struct {
+	Plot   *plot.Plot
+	Shaper *text.Shaper
+
+	// LockX disables panning and zooming of the X axis.
+	LockX bool
+	// LockY disables panning and zooming of the Y axis.
+	LockY bool
+
+	// ZoomSpeed determines how much a single scroll pixel zooms.
+	ZoomSpeed float64
+
+	// Tooltip enables showing the nearest data item under the pointer.
+	Tooltip bool
+	// TooltipRadius is the maximum distance to the data item for showing the tooltip.
+	TooltipRadius plot.Length
+
+	// AutoMargin updates the plot margins using plot.Layout before drawing.
+	AutoMargin bool
+
+	home struct {
+		saved  bool
+		x0, x1 float64
+		y0, y1 float64
+	}
+
+	bounds    plot.Rect
+	pointers  map[pointer.ID]f32.Point
+	lastPress time.Duration
+
+	hover struct {
+		active bool
+		at     plot.Point
+	}
+
+	linkedX []*Plot
+	linkedY []*Plot
+
+	images ImageCache
+}
+
+// NewPlot creates a new interactive plot widget.
+func NewPlot(shaper *text.Shaper, p *plot.Plot) *Plot {
+	return &Plot{
+		Plot:      p,
+		Shaper:    shaper,
+		ZoomSpeed: 0.005,
+
+		Tooltip:       true,
+		TooltipRadius: 32,
+
+		pointers: map[pointer.ID]f32.Point{},
+	}
+}
+
+// LinkX links X axes of the plots such that panning and zooming one of them updates all.
+func LinkX(plots ...*Plot) {
+	for _, a := range plots {
+		for _, b := range plots {
+			if a != b {
+				a.linkedX = append(a.linkedX, b)
+			}
+		}
+	}
+}
+
+// LinkY links Y axes of the plots such that panning and zooming one of them updates all.
+func LinkY(plots ...*Plot) {
+	for _, a := range plots {
+		for _, b := range plots {
+			if a != b {
+				a.linkedY = append(a.linkedY, b)
+			}
+		}
+	}
+}
+
+// Layout handles input events and draws the plot.
+func (w *Plot) Layout(gtx layout.Context) layout.Dimensions {
+	size := layout.FPt(gtx.Constraints.Max)
+	canvas := New(w.Shaper, size)
+	canvas.Images = &w.images
+	if w.AutoMargin {
+		w.Plot.Layout(canvas)
+	}
+
+	w.bounds = w.Plot.Area(canvas)
+
+	w.update(gtx)
+
+	defer clip.Rect(image.Rectangle{Max: gtx.Constraints.Max}).Push(gtx.Ops).Pop()
+	pointer.InputOp{
+		Tag:  w,
+		Grab: len(w.pointers) > 0,
+		Types: pointer.Press | pointer.Drag | pointer.Release | pointer.Cancel | pointer.Scroll |
+			pointer.Move | pointer.Enter | pointer.Leave,
+		ScrollBounds: image.Rectangle{
+			Min: image.Point{X: math.MinInt32, Y: math.MinInt32},
+			Max: image.Point{X: math.MaxInt32, Y: math.MaxInt32},
+		},
+	}.Add(gtx.Ops)
+
+	w.Plot.Draw(canvas)
+	if w.Tooltip && w.hover.active {
+		hit, ok := w.Plot.HitTest(canvas, w.hover.at)
+		if ok && hit.Distance <= w.TooltipRadius {
+			drawTooltip(canvas.Layer(tooltipLayer), &w.Plot.Theme, hit)
+		}
+	}
+	canvas.Add(gtx)
+
+	return layout.Dimensions{Size: gtx.Constraints.Max}
+}
+
+// update processes pointer events.
+func (w *Plot) update(gtx layout.Context) {
+	if w.pointers == nil {
+		w.pointers = map[pointer.ID]f32.Point{}
+	}
+
+	changed, invalidate := false, false
+	for _, ev := range gtx.Events(w) {
+		e, ok := ev.(pointer.Event)
+		if !ok {
+			continue
+		}
+
+		switch e.Type {
+		case pointer.Move, pointer.Enter, pointer.Drag:
+			w.hover.active = true
+			w.hover.at = point(e.Position)
+		case pointer.Leave, pointer.Cancel:
+			w.hover.active = false
+		}
+
+		switch e.Type {
+		case pointer.Press:
+			if len(w.pointers) == 0 {
+				if e.Time-w.lastPress < doubleClickDuration {
+					w.Reset()
+					invalidate = true
+				}
+				w.lastPress = e.Time
+			}
+			w.pointers[e.PointerID] = e.Position
+
+		case pointer.Drag:
+			last, ok := w.pointers[e.PointerID]
+			if !ok {
+				continue
+			}
+			w.pointers[e.PointerID] = e.Position
+
+			other, pinch := w.otherPointer(e.PointerID)
+			if !pinch {
+				w.transform(point(last), 1, point(e.Position.Sub(last)))
+				changed = true
+				continue
+			}
+
+			before := point(last).Add(point(other)).Scale(0.5)
+			after := point(e.Position).Add(point(other)).Scale(0.5)
+			beforeDist := distance(last, other)
+			afterDist := distance(e.Position, other)
+			if beforeDist > 0 && afterDist > 0 {
+				w.transform(before, afterDist/beforeDist, after.Sub(before))
+				changed = true
+			}
+
+		case pointer.Release, pointer.Cancel:
+			delete(w.pointers, e.PointerID)
+
+		case pointer.Scroll:
+			scale := math.Exp(-float64(e.Scroll.Y) * w.ZoomSpeed)
+			w.transform(point(e.Position), scale, plot.Point{})
+			changed = true
+		}
+	}
+
+	if changed {
+		w.sync()
+		invalidate = true
+	}
+	if invalidate && (len(w.linkedX) > 0 || len(w.linkedY) > 0) {
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+}
+
+// otherPointer returns the position of the second active pointer, when there is one.
+func (w *Plot) otherPointer(id pointer.ID) (f32.Point, bool) {
+	for other, pos := range w.pointers {
+		if other != id {
+			return pos, true
+		}
+	}
+	return f32.Point{}, false
+}
+
+// save remembers the initial axes and makes them valid for modification.
+func (w *Plot) save() {
+	if !w.home.saved {
+		w.home.saved = true
+		w.home.x0, w.home.x1 = w.Plot.X.Min, w.Plot.X.Max
+		w.home.y0, w.home.y1 = w.Plot.Y.Min, w.Plot.Y.Max
+	}
+	if !w.Plot.X.IsValid() || !w.Plot.Y.IsValid() {
+		w.Plot.Update()
+	}
+}
+
+// Reset restores the axes to the state before any panning or zooming.
+func (w *Plot) Reset() {
+	w.reset()
+	for _, other := range w.linkedX {
+		other.reset()
+	}
+	for _, other := range w.linkedY {
+		other.reset()
+	}
+}
+
+// reset restores the axes to the state before any panning or zooming.
+func (w *Plot) reset() {
+	if !w.home.saved {
+		return
+	}
+	w.Plot.X.Min, w.Plot.X.Max = w.home.x0, w.home.x1
+	w.Plot.Y.Min, w.Plot.Y.Max = w.home.y0, w.home.y1
+}
+
+// transform changes the axes such that the screen position s
+// moves to center + (s - center) * scale + delta.
+func (w *Plot) transform(center plot.Point, scale float64, delta plot.Point) {
+	w.save()
+
+	center = center.Sub(w.bounds.Min)
+	size := w.bounds.Size()
+	if !w.LockX {
+		transformAxis(w.Plot.X, size.X, center.X, scale, delta.X)
+	}
+	if !w.LockY {
+		transformAxis(w.Plot.Y, size.Y, center.Y, scale, delta.Y)
+	}
+}
+
+// sync copies the axis ranges to the linked plots.
+func (w *Plot) sync() {
+	for _, other := range w.linkedX {
+		other.save()
+		other.Plot.X.Min, other.Plot.X.Max = w.Plot.X.Min, w.Plot.X.Max
+	}
+	for _, other := range w.linkedY {
+		other.save()
+		other.Plot.Y.Min, other.Plot.Y.Max = w.Plot.Y.Min, w.Plot.Y.Max
+	}
+}
+
+// transformAxis changes axis range such that screen position s
+// moves to center + (s - center) * scale + delta.
+func transformAxis(axis *plot.Axis, size, center, scale, delta float64) {
+	if size <= 0 || scale <= 0 {
+		return
+	}
+
+	a := axis.FromCanvas(center+(0-delta-center)/scale, 0, size)
+	b := axis.FromCanvas(center+(size-delta-center)/scale, 0, size)
+	if !isFinite(a) || !isFinite(b) || a == b {
+		return
+	}
+
+	axis.Min, axis.Max = math.Min(a, b), math.Max(a, b)
+}
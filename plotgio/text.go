@@ -0,0 +1,166 @@
+package plotgio
+
+import (
+	"image/color"
+	"math"
+	"strings"
+
+	"gioui.org/f32"
+	"gioui.org/io/system"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/loov/plot"
+)
+
+// textStrokeWidth is the outline width used for text with Style.Stroke.
+const textStrokeWidth = 1
+
+// shapedText describes a laid out text.
+type shapedText struct {
+	lines []shapedLine
+	// bounds is the logical bounds of the text relative to the top-left corner.
+	bounds plot.Rect
+	// baseline is the distance from the top to the first baseline.
+	baseline plot.Length
+}
+
+// shapedLine describes a single line of glyphs.
+type shapedLine struct {
+	offset f32.Point
+	glyphs []text.Glyph
+}
+
+// layoutText shapes the text using the style.
+func layoutText(shaper *text.Shaper, txt string, style *plot.Style) shapedText {
+	size := style.Size
+	if size == 0 {
+		size = 12
+	}
+
+	shaper.LayoutString(text.Parameters{
+		Font:    convertFont(style.Font),
+		PxPerEm: fixed.Int26_6(math.Round(size * 64)),
+	}, 0, math.MaxInt32, system.Locale{}, txt)
+
+	var shaped shapedText
+	var line shapedLine
+	first := true
+	for g, ok := shaper.NextGlyph(); ok; g, ok = shaper.NextGlyph() {
+		if len(line.glyphs) == 0 {
+			line.offset = f32.Point{X: float32(g.X.Floor()), Y: float32(g.Y)}
+		}
+		line.glyphs = append(line.glyphs, g)
+
+		bounds := plot.Rect{
+			Min: plot.Point{X: fromFixed(g.X), Y: float64(g.Y) - fromFixed(g.Ascent)},
+			Max: plot.Point{X: fromFixed(g.X + g.Advance), Y: float64(g.Y) + fromFixed(g.Descent)},
+		}
+		if first {
+			first = false
+			shaped.bounds = bounds
+			shaped.baseline = plot.Length(g.Y)
+		} else {
+			shaped.bounds.Min = shaped.bounds.Min.Min(bounds.Min)
+			shaped.bounds.Max = shaped.bounds.Max.Max(bounds.Max)
+		}
+
+		if g.Flags&text.FlagLineBreak != 0 {
+			shaped.lines = append(shaped.lines, line)
+			line = shapedLine{}
+		}
+	}
+	if len(line.glyphs) > 0 {
+		shaped.lines = append(shaped.lines, line)
+	}
+
+	return shaped
+}
+
+// anchor calculates the offset of the top-left corner from the text origin.
+//
+// Origin.X -1, 0, 1 aligns text start, middle and end respectively.
+// Origin.Y -1, 0, 1 aligns text top, middle and baseline respectively.
+func (shaped *shapedText) anchor(origin plot.Point) plot.Point {
+	size := shaped.bounds.Size()
+
+	var offset plot.Point
+	offset.X = -shaped.bounds.Min.X - (origin.X+1)*0.5*size.X
+
+	top := -shaped.bounds.Min.Y
+	middle := top - size.Y*0.5
+	baseline := -shaped.baseline
+	if origin.Y <= 0 {
+		offset.Y = top + (origin.Y+1)*(middle-top)
+	} else {
+		offset.Y = middle + origin.Y*(baseline-middle)
+	}
+
+	return offset
+}
+
+func (c *Canvas) addText(el *element, gtx layout.Context) {
+	style := &el.style
+	if style.Font == "" && style.Size == 0 && style.Stroke == nil && style.Fill == nil {
+		return
+	}
+
+	shaped := layoutText(c.Shaper, el.text, style)
+	anchor := shaped.anchor(style.Origin)
+
+	transform := f32.Affine2D{}.
+		Offset(pt(anchor)).
+		Rotate(f32.Point{}, float32(style.Rotation)).
+		Offset(pt(el.origin))
+	defer op.Affine(transform).Push(gtx.Ops).Pop()
+
+	fill := style.Fill
+	if fill == nil {
+		fill = color.Black
+	}
+
+	for _, line := range shaped.lines {
+		t := op.Offset(line.offset.Round()).Push(gtx.Ops)
+		path := c.Shaper.Shape(line.glyphs)
+
+		paint.FillShape(gtx.Ops, c.color(fill), clip.Outline{Path: path}.Op())
+		if style.Stroke != nil {
+			paint.FillShape(gtx.Ops, c.color(style.Stroke), clip.Stroke{
+				Path:  path,
+				Width: textStrokeWidth,
+			}.Op())
+		}
+
+		t.Pop()
+	}
+}
+
+// convertFont converts a font family list to a Gio font.
+//
+// Only the first family is used, the lookup is done by the
+// shaper from its font collection.
+func convertFont(family string) text.Font {
+	if i := strings.IndexByte(family, ','); i >= 0 {
+		family = family[:i]
+	}
+	family = strings.Trim(strings.TrimSpace(family), `"'`)
+
+	switch strings.ToLower(family) {
+	case "":
+		return text.Font{}
+	case "serif", "sans-serif", "system-ui":
+		return text.Font{}
+	case "monospace":
+		return text.Font{Variant: "Mono"}
+	}
+	return text.Font{Typeface: text.Typeface(family)}
+}
+
+// fromFixed converts fixed point value to float.
+func fromFixed(v fixed.Int26_6) plot.Length {
+	return plot.Length(v) / 64
+}
@@ -0,0 +1,62 @@
+package plotgio
+
+import (
+	"github.com/loov/plot"
+)
+
+// tooltipLayer is the layer index used for drawing tooltips above the plot.
+const tooltipLayer = 1000
+
+// drawTooltip draws a tooltip box describing the hit.
+func drawTooltip(canvas plot.Canvas, theme *plot.Theme, hit plot.Hit) {
+	lines := hit.Text()
+
+	font := theme.FontSmall
+	font.Origin = plot.P(-1, -1)
+	if font.Size == 0 {
+		font.Size = 10
+	}
+
+	const pad = 4
+	lineHeight := font.Size * 1.2
+
+	var measurer plot.TextMeasurer = plot.ApproxTextMeasurer{}
+	if m, ok := canvas.(plot.TextMeasurer); ok {
+		measurer = m
+	}
+
+	width := 0.0
+	for _, line := range lines {
+		if w := measurer.MeasureText(line, &font).Size().X; w > width {
+			width = w
+		}
+	}
+	height := float64(len(lines)) * lineHeight
+
+	bounds := canvas.Bounds()
+	box := plot.R(0, 0, width+2*pad, height+2*pad).Offset(hit.Position.Add(plot.P(8, 8)))
+	if box.Max.X > bounds.Max.X {
+		box = box.Offset(plot.P(-box.Size().X-16, 0))
+	}
+	if box.Max.Y > bounds.Max.Y {
+		box = box.Offset(plot.P(0, -box.Size().Y-16))
+	}
+
+	marker := plot.R(-3, -3, 3, 3).Offset(hit.Position)
+	canvas.Rect(marker, &plot.Style{
+		Stroke: theme.Line.Stroke,
+		Size:   1,
+	})
+
+	canvas.Rect(box, &plot.Style{
+		Stroke: theme.Line.Stroke,
+		Fill:   theme.Fill.Fill,
+		Size:   1,
+	})
+
+	at := box.Min.Add(plot.P(pad, pad))
+	for _, line := range lines {
+		canvas.Text(line, at, &font)
+		at.Y += lineHeight
+	}
+}
@@ -2,10 +2,12 @@ package plotgio
 
 import (
 	"image/color"
+	"math"
 
 	"gioui.org/f32"
 	"gioui.org/op"
 	"gioui.org/op/clip"
+	"gioui.org/x/stroke"
 	"github.com/loov/plot"
 )
 
@@ -13,6 +15,22 @@ func pt(p plot.Point) f32.Point {
 	return f32.Point{X: float32(p.X), Y: float32(p.Y)}
 }
 
+// point converts f32.Point to plot.Point.
+func point(p f32.Point) plot.Point {
+	return plot.Point{X: plot.Length(p.X), Y: plot.Length(p.Y)}
+}
+
+// distance calculates distance between two points.
+func distance(a, b f32.Point) float64 {
+	d := a.Sub(b)
+	return math.Hypot(float64(d.X), float64(d.Y))
+}
+
+// isFinite returns whether v is neither NaN nor infinity.
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
 func pushClipRect(r plot.Rect, ops *op.Ops) clip.Stack {
 	var p clip.Path
 	p.Begin(ops)
@@ -31,6 +49,70 @@ func mustExist(style *plot.Style) {
 	}
 }
 
+// miterLimit is the limit for miter joins, same as the SVG default.
+const miterLimit = 4
+
+// convertCap converts line cap to stroke cap.
+func convertCap(cap plot.LineCap) stroke.StrokeCap {
+	switch cap {
+	case plot.RoundCap:
+		return stroke.RoundCap
+	case plot.SquareCap:
+		return stroke.SquareCap
+	default:
+		return stroke.FlatCap
+	}
+}
+
+// convertJoin converts line join to stroke join.
+func convertJoin(join plot.LineJoin) stroke.StrokeJoin {
+	switch join {
+	case plot.MiterJoin:
+		return stroke.MiterJoin
+	case plot.BevelJoin:
+		return stroke.BevelJoin
+	default:
+		return stroke.RoundJoin
+	}
+}
+
+// convertDashes converts style dash pattern to stroke dashes.
+//
+// The stroke path is split into dashes according to the pattern,
+// starting at style.DashOffset[0] along the path.
+func convertDashes(style *plot.Style) stroke.Dashes {
+	if len(style.Dash) == 0 {
+		return stroke.Dashes{}
+	}
+
+	dashes := make([]float32, 0, 2*len(style.Dash))
+	for _, v := range style.Dash {
+		dashes = append(dashes, float32(v))
+	}
+	// odd number of values is repeated to yield an even number, as in SVG
+	if len(style.Dash)%2 == 1 {
+		dashes = append(dashes, dashes...)
+	}
+
+	var phase float32
+	if len(style.DashOffset) > 0 {
+		phase = float32(style.DashOffset[0])
+	}
+	return stroke.Dashes{
+		Phase:  phase,
+		Dashes: dashes,
+	}
+}
+
+// transparent checks whether col is missing or fully transparent.
+func transparent(col color.Color) bool {
+	if col == nil {
+		return true
+	}
+	_, _, _, a := col.RGBA()
+	return a == 0
+}
+
 // convertColor converts color to an hex encoded string.
 func convertColor(col color.Color) color.NRGBA {
 	r, g, b, a := col.RGBA()
@@ -2,8 +2,12 @@ package plotsvg
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/xml"
 	"fmt"
+	"image"
+	"image/color"
+	"image/png"
 	"io"
 	"math"
 	"sort"
@@ -11,27 +15,74 @@ import (
 	"github.com/loov/plot"
 )
 
-var _ plot.Canvas = (*Canvas)(nil)
+var (
+	_ plot.PathCanvas    = (*Canvas)(nil)
+	_ plot.OpacityCanvas = (*Canvas)(nil)
+	_ plot.ImageCanvas   = (*Canvas)(nil)
+)
 
 // Canvas describes the top-level svg drawing context.
 type Canvas struct {
 	Style string
+	// Title and Description are written as <title> and <desc> for accessibility.
+	Title       string
+	Description string
 	context
+
+	// themeStyle is the last style derived from a theme.
+	themeStyle string
 }
 
 // New creates a new SVG canvas.
 func New(width, height plot.Length) *Canvas {
 	svg := &Canvas{}
-	svg.Style = `text { text-shadow: -1px -1px 0 rgba(255,255,255,0.5),	1px -1px 0 rgba(255,255,255,0.5), 1px  1px 0 rgba(255,255,255,0.5), -1px  1px 0 rgba(255,255,255,0.5); }`
+	svg.Style = textHaloStyle(color.NRGBA{255, 255, 255, 128})
+	svg.themeStyle = svg.Style
 	svg.bounds.Max.X = width
 	svg.bounds.Max.Y = height
 	return svg
 }
 
+// SetTheme updates the global style using the theme,
+// unless Style has been modified.
+func (svg *Canvas) SetTheme(theme *plot.Theme) {
+	if svg.Style != svg.themeStyle {
+		return
+	}
+	svg.Style = textHaloStyle(theme.Halo)
+	svg.themeStyle = svg.Style
+}
+
+// SetDescription updates the title and description, when they are not empty.
+func (svg *Canvas) SetDescription(title, description string) {
+	if title != "" {
+		svg.Title = title
+	}
+	if description != "" {
+		svg.Description = description
+	}
+}
+
+// textHaloStyle creates a style that outlines text with halo color.
+func textHaloStyle(halo color.Color) string {
+	if halo == nil {
+		return ""
+	}
+	c := color.NRGBAModel.Convert(halo).(color.NRGBA)
+	if c.A == 0 {
+		return ""
+	}
+	rgba := fmt.Sprintf("rgba(%d,%d,%d,%.2f)", c.R, c.G, c.B, float64(c.A)/0xff)
+	return fmt.Sprintf(`text { text-shadow: -1px -1px 0 %[1]v, 1px -1px 0 %[1]v, 1px 1px 0 %[1]v, -1px 1px 0 %[1]v; }`, rgba)
+}
+
 // context describes a svg drawing context.
 type context struct {
 	index int
 	clip  bool
+	// opacity is applied to the whole context, when grouped is set
+	grouped bool
+	opacity float64
 	// bounds relative to parent
 	bounds   plot.Rect
 	elements []element
@@ -44,6 +95,14 @@ type element struct {
 	style plot.Style
 	// line
 	points []plot.Point
+	// path
+	path *plot.Path
+	// circle or ellipse
+	ellipse        bool
+	center, radius plot.Point
+	// image
+	image image.Image
+	dst   plot.Rect
 	// text
 	text   string
 	origin plot.Point
@@ -88,6 +147,17 @@ func (svg *context) Clip(r plot.Rect) plot.Canvas {
 	return svg.context(r, true)
 }
 
+// Opacity returns a context that is composited with alpha as a group.
+func (svg *context) Opacity(alpha float64) plot.Canvas {
+	element := element{}
+	element.context = &context{}
+	element.context.bounds = svg.Bounds()
+	element.context.grouped = true
+	element.context.opacity = alpha
+	svg.elements = append(svg.elements, element)
+	return element.context
+}
+
 // Layer returns an layer above or below current state.
 func (svg *context) Layer(index int) plot.Canvas {
 	if index == 0 {
@@ -111,6 +181,11 @@ func (svg *context) Layer(index int) plot.Canvas {
 	}
 }
 
+// MeasureText approximates the bounds of text drawn at (0, 0).
+func (svg *context) MeasureText(text string, style *plot.Style) plot.Rect {
+	return plot.ApproxTextMeasurer{}.MeasureText(text, style)
+}
+
 // Text draws text.
 func (svg *context) Text(text string, at plot.Point, style *plot.Style) {
 	mustExist(style)
@@ -135,6 +210,41 @@ func (svg *context) Rect(r plot.Rect, style *plot.Style) {
 	svg.Poly(r.Points(), style)
 }
 
+// Path draws a path.
+func (svg *context) Path(path *plot.Path, style *plot.Style) {
+	mustExist(style)
+	svg.elements = append(svg.elements, element{
+		path:  path,
+		style: *style,
+	})
+}
+
+// Circle draws a circle.
+func (svg *context) Circle(center plot.Point, radius plot.Length, style *plot.Style) {
+	svg.Ellipse(center, plot.Point{X: radius, Y: radius}, style)
+}
+
+// Ellipse draws an ellipse.
+func (svg *context) Ellipse(center, radius plot.Point, style *plot.Style) {
+	mustExist(style)
+	svg.elements = append(svg.elements, element{
+		ellipse: true,
+		center:  center,
+		radius:  radius,
+		style:   *style,
+	})
+}
+
+// Image draws an image.
+func (svg *context) Image(img image.Image, dst plot.Rect, style *plot.Style) {
+	mustExist(style)
+	svg.elements = append(svg.elements, element{
+		image: img,
+		dst:   dst,
+		style: *style,
+	})
+}
+
 // WriteTo writes svg content to dst.
 func (svg *Canvas) WriteTo(dst io.Writer) (n int64, err error) {
 	w := &writer{}
@@ -146,9 +256,20 @@ func (svg *Canvas) WriteTo(dst io.Writer) (n int64, err error) {
 	w.Print(`<?xml version="1.0" standalone="no"?>`)
 	w.Print(`<!DOCTYPE svg PUBLIC "-//W3C//DTD Canvas 1.0//EN" "http://www.w3.org/TR/2001/REC-Canvas-20010904/DTD/svg10.dtd">`)
 	size := svg.bounds.Size()
-	w.Print(`<svg xmlns='http://www.w3.org/2000/svg' xmlns:loov='http://www.loov.io' width='%vpx' height='%vpx' viewBox='0 0 %v %v'>`, size.X, size.Y, size.X, size.Y)
+	w.Print(`<svg xmlns='http://www.w3.org/2000/svg' xmlns:xlink='http://www.w3.org/1999/xlink' xmlns:loov='http://www.loov.io' width='%vpx' height='%vpx' viewBox='0 0 %v %v'>`, size.X, size.Y, size.X, size.Y)
 	defer w.Print(`</svg>`)
 
+	if svg.Title != "" {
+		w.Printf(`<title>`)
+		xml.EscapeText(w, []byte(svg.Title))
+		w.Print(`</title>`)
+	}
+	if svg.Description != "" {
+		w.Printf(`<desc>`)
+		xml.EscapeText(w, []byte(svg.Description))
+		w.Print(`</desc>`)
+	}
+
 	if svg.Style != "" {
 		// TODO: escape CDATA
 		w.Print(`<style>/* <![CDATA[ */ %v /* ]]> */ </style>`, svg.Style)
@@ -175,6 +296,9 @@ func (svg *Canvas) WriteTo(dst io.Writer) (n int64, err error) {
 		if svg.clip {
 			w.Printf(` clip-path='url(#clip%v)'`, id)
 		}
+		if svg.grouped {
+			w.Printf(` opacity='%.2f'`, svg.opacity)
+		}
 
 		w.Print(">")
 		defer w.Print(`</g>`)
@@ -207,18 +331,50 @@ func (svg *Canvas) WriteTo(dst io.Writer) (n int64, err error) {
 
 	writeElement = func(svg *context, el *element) {
 		if len(el.points) > 0 {
+			fill := w.writeGradient(el.style.FillGradient)
 			w.Printf(`<polyline `)
-			w.writePolyStyle(&el.style)
+			w.writePolyStyle(&el.style, fill)
 			w.Printf(` points='`)
 			for _, p := range el.points {
 				w.Printf(`%.2f,%.2f `, p.X, p.Y)
 			}
-			w.Print(`' />`)
+			if el.style.Title != "" {
+				w.Printf(`'>`)
+				w.writeTitle(&el.style)
+				w.Print(`</polyline>`)
+			} else {
+				w.Print(`' />`)
+			}
+		}
+		if el.path != nil && len(el.path.Segments) > 0 {
+			fill := w.writeGradient(el.style.FillGradient)
+			w.Printf(`<path `)
+			w.writePolyStyle(&el.style, fill)
+			w.Printf(` d='`)
+			w.writePathData(el.path)
+			w.Printf(`'`)
+			w.writeEnd(`path`, &el.style)
+		}
+		if el.ellipse {
+			fill := w.writeGradient(el.style.FillGradient)
+			if el.radius.X == el.radius.Y {
+				w.Printf(`<circle cx='%.2f' cy='%.2f' r='%.2f' `, el.center.X, el.center.Y, el.radius.X)
+				w.writePolyStyle(&el.style, fill)
+				w.writeEnd(`circle`, &el.style)
+			} else {
+				w.Printf(`<ellipse cx='%.2f' cy='%.2f' rx='%.2f' ry='%.2f' `, el.center.X, el.center.Y, el.radius.X, el.radius.Y)
+				w.writePolyStyle(&el.style, fill)
+				w.writeEnd(`ellipse`, &el.style)
+			}
+		}
+		if el.image != nil {
+			w.writeImage(el.image, el.dst, &el.style)
 		}
 		if el.text != "" {
 			w.Printf(`<text x='%.2f' y='%.2f' `, el.origin.X, el.origin.Y)
-			w.writeTextStyle(&el.style)
+			w.writeTextStyle(&el.style, el.origin)
 			w.Printf(`>`)
+			w.writeTitle(&el.style)
 			xml.EscapeText(w, []byte(el.text))
 			w.Print(`</text>`)
 		}
@@ -232,8 +388,76 @@ func (svg *Canvas) WriteTo(dst io.Writer) (n int64, err error) {
 	return w.total, w.err
 }
 
-// writeTextStyle writes text styling.
-func (w *writer) writeTextStyle(style *plot.Style) {
+// writePathData writes path segments as svg path data.
+func (w *writer) writePathData(path *plot.Path) {
+	for _, segment := range path.Segments {
+		p := segment.Points
+		switch segment.Op {
+		case plot.PathMoveTo:
+			w.Printf(`M%.2f,%.2f `, p[0].X, p[0].Y)
+		case plot.PathLineTo:
+			w.Printf(`L%.2f,%.2f `, p[0].X, p[0].Y)
+		case plot.PathQuadTo:
+			w.Printf(`Q%.2f,%.2f %.2f,%.2f `, p[0].X, p[0].Y, p[1].X, p[1].Y)
+		case plot.PathCubicTo:
+			w.Printf(`C%.2f,%.2f %.2f,%.2f %.2f,%.2f `, p[0].X, p[0].Y, p[1].X, p[1].Y, p[2].X, p[2].Y)
+		case plot.PathClose:
+			w.Printf(`Z `)
+		}
+	}
+}
+
+// writeImage writes img as an embedded png.
+func (w *writer) writeImage(img image.Image, dst plot.Rect, style *plot.Style) {
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		if w.err == nil {
+			w.err = err
+		}
+		return
+	}
+
+	size := dst.Size()
+	w.Printf(`<image x='%.2f' y='%.2f' width='%.2f' height='%.2f' preserveAspectRatio='none' `, dst.Min.X, dst.Min.Y, size.X, size.Y)
+	if style.Class != "" {
+		w.Printf(`class='`)
+		xml.EscapeText(w, []byte(style.Class))
+		w.Printf(`' `)
+	}
+	switch style.Sampling {
+	case plot.SampleNearest:
+		w.Printf(`image-rendering='optimizeSpeed' style='image-rendering: pixelated;' `)
+	case plot.SampleLinear:
+		w.Printf(`image-rendering='optimizeQuality' `)
+	}
+	w.Printf(`xlink:href='data:image/png;base64,`)
+	w.Printf(`%s'`, base64.StdEncoding.EncodeToString(data.Bytes()))
+	w.writeEnd(`image`, style)
+}
+
+// writeEnd closes an element, including the title when present.
+func (w *writer) writeEnd(tag string, style *plot.Style) {
+	if style.Title != "" {
+		w.Printf(`>`)
+		w.writeTitle(style)
+		w.Print(`</` + tag + `>`)
+	} else {
+		w.Print(` />`)
+	}
+}
+
+// writeTitle writes title for tooltips.
+func (w *writer) writeTitle(style *plot.Style) {
+	if style.Title == "" {
+		return
+	}
+	w.Printf(`<title>`)
+	xml.EscapeText(w, []byte(style.Title))
+	w.Printf(`</title>`)
+}
+
+// writeTextStyle writes text styling, rotating around the text origin.
+func (w *writer) writeTextStyle(style *plot.Style, origin plot.Point) {
 	// TODO: merge with writePolyStyle
 	if style.Class != "" {
 		w.Printf(` class='`)
@@ -242,7 +466,7 @@ func (w *writer) writeTextStyle(style *plot.Style) {
 	}
 
 	if style.Rotation != 0 {
-		w.Printf(`transform="rotate(%.2f)" `, style.Rotation*180/math.Pi)
+		w.Printf(`transform="rotate(%.2f %.2f %.2f)" `, style.Rotation*180/math.Pi, origin.X, origin.Y)
 	}
 
 	if style.Origin.X == 0 {
@@ -293,15 +517,52 @@ func (w *writer) writeTextStyle(style *plot.Style) {
 	}
 }
 
-// writePolyStyle writes a polygon class and styling.
-func (w *writer) writePolyStyle(style *plot.Style) {
+// writeGradient writes the gradient definition and returns its id.
+func (w *writer) writeGradient(gradient *plot.Gradient) string {
+	if gradient == nil || len(gradient.Stops) == 0 {
+		return ""
+	}
+
+	w.gradients++
+	id := fmt.Sprintf("gradient%v", w.gradients)
+
+	units := "userSpaceOnUse"
+	if gradient.Units == plot.GradientRelative {
+		units = "objectBoundingBox"
+	}
+
+	tag := "linearGradient"
+	if gradient.Radial {
+		tag = "radialGradient"
+		w.Printf(`<radialGradient id='%v' gradientUnits='%v' cx='%.4g' cy='%.4g' r='%.4g'>`,
+			id, units, gradient.Start.X, gradient.Start.Y, gradient.Radius())
+	} else {
+		w.Printf(`<linearGradient id='%v' gradientUnits='%v' x1='%.4g' y1='%.4g' x2='%.4g' y2='%.4g'>`,
+			id, units, gradient.Start.X, gradient.Start.Y, gradient.End.X, gradient.End.Y)
+	}
+	for _, stop := range gradient.Stops {
+		color, opacity := convertColorToHex(stop.Color)
+		w.Printf(`<stop offset='%.4g' stop-color='%v'`, stop.Offset, color)
+		if opacity != "" {
+			w.Printf(` stop-opacity='%v'`, opacity)
+		}
+		w.Printf(` />`)
+	}
+	w.Print(`</` + tag + `>`)
+
+	return id
+}
+
+// writePolyStyle writes a polygon class and styling,
+// fill is the id of the gradient replacing style.Fill.
+func (w *writer) writePolyStyle(style *plot.Style, fill string) {
 	if style.Class != "" {
 		w.Printf(` class='`)
 		xml.EscapeText(w, []byte(style.Class))
 		w.Printf(`' `)
 	}
 
-	if style.Size == 0 && style.Stroke == nil && style.Fill == nil && len(style.Dash) == 0 {
+	if style.Size == 0 && style.Stroke == nil && style.Fill == nil && fill == "" && len(style.Dash) == 0 {
 		return
 	}
 
@@ -318,7 +579,9 @@ func (w *writer) writePolyStyle(style *plot.Style) {
 		w.Printf(`stroke: transparent;`)
 	}
 
-	if style.Fill != nil {
+	if fill != "" {
+		w.Printf(`fill: url(#%v);`, fill)
+	} else if style.Fill != nil {
 		color, opacity := convertColorToHex(style.Fill)
 		w.Printf(`fill: %v;`, color)
 		if opacity != "" {
@@ -334,13 +597,30 @@ func (w *writer) writePolyStyle(style *plot.Style) {
 			w.Printf(` %v`, v)
 		}
 		w.Printf(`;`)
+		if len(style.DashOffset) > 0 && style.DashOffset[0] != 0 {
+			w.Printf(`stroke-dashoffset: %v;`, style.DashOffset[0])
+		}
+	}
+
+	switch style.Cap {
+	case plot.RoundCap:
+		w.Printf(`stroke-linecap: round;`)
+	case plot.SquareCap:
+		w.Printf(`stroke-linecap: square;`)
+	}
+
+	switch style.Join {
+	case plot.MiterJoin:
+		w.Printf(`stroke-linejoin: miter;`)
+	case plot.RoundJoin:
+		w.Printf(`stroke-linejoin: round;`)
+	case plot.BevelJoin:
+		w.Printf(`stroke-linejoin: bevel;`)
 	}
 
 	if style.Size != 0 {
 		w.Printf(`stroke-width: %vpx;`, style.Size)
 	}
-
-	// TODO: dash
 }
 
 // writer implements error capturing/hiding writer.
@@ -348,6 +628,9 @@ type writer struct {
 	io.Writer
 	total int64
 	err   error
+
+	// gradients is the number of written gradients.
+	gradients int
 }
 
 // Errored returns whether there has been an error during writing.
@@ -0,0 +1,41 @@
+package plotsvg
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/loov/plot"
+)
+
+func TestDashStyle(t *testing.T) {
+	black := color.NRGBA{0, 0, 0, 255}
+	tests := []struct {
+		name     string
+		style    plot.Style
+		expected string
+		missing  string
+	}{
+		{"solid", plot.Style{Stroke: black, Size: 1}, "", "stroke-dash"},
+		{"dash", plot.Style{Stroke: black, Dash: []plot.Length{4, 2}}, "stroke-dasharray: 4 2;", "stroke-dashoffset"},
+		{"odd", plot.Style{Stroke: black, Dash: []plot.Length{3}}, "stroke-dasharray: 3;", ""},
+		{"offset", plot.Style{Stroke: black, Dash: []plot.Length{4, 2}, DashOffset: []plot.Length{1.5}}, "stroke-dasharray: 4 2;stroke-dashoffset: 1.5;", ""},
+		{"zero offset", plot.Style{Stroke: black, Dash: []plot.Length{4, 2}, DashOffset: []plot.Length{0}}, "stroke-dasharray: 4 2;", "stroke-dashoffset"},
+		{"offset without dash", plot.Style{Stroke: black, DashOffset: []plot.Length{1}}, "", "stroke-dash"},
+		{"round join", plot.Style{Stroke: black, Join: plot.RoundJoin, Cap: plot.RoundCap}, "stroke-linecap: round;stroke-linejoin: round;", ""},
+		{"default join", plot.Style{Stroke: black}, "", "stroke-linejoin"},
+	}
+
+	for _, test := range tests {
+		svg := New(100, 100)
+		svg.Poly([]plot.Point{{X: 0, Y: 0}, {X: 100, Y: 100}}, &test.style)
+		out := string(svg.Bytes())
+
+		if !strings.Contains(out, test.expected) {
+			t.Errorf("%s: expected %q in\n%s", test.name, test.expected, out)
+		}
+		if test.missing != "" && strings.Contains(out, test.missing) {
+			t.Errorf("%s: unexpected %q in\n%s", test.name, test.missing, out)
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package plot
+
+import "math"
+
+// Polar is a coordinate mode, which maps the X axis to the angle
+// and the Y axis to the radius.
+//
+// The radius axis ignores Flip, the minimum is always at the center.
+type Polar struct {
+	// Start is the angle of the X axis low end in radians,
+	// measured clockwise from the top.
+	Start float64
+	// Sweep is the angle covered by the X axis, 0 means a full circle.
+	Sweep float64
+	// Hole is the fraction of the radius left empty at the center.
+	Hole float64
+}
+
+// NewPolar creates a full circle polar coordinate mode.
+func NewPolar() *Polar {
+	return &Polar{}
+}
+
+// full returns whether the X axis covers a full circle.
+func (polar *Polar) full() bool {
+	return polar.Sweep == 0 || math.Abs(polar.Sweep) >= 2*math.Pi
+}
+
+// sweep returns the angle covered by the X axis.
+func (polar *Polar) sweep() float64 {
+	if polar.Sweep == 0 {
+		return 2 * math.Pi
+	}
+	return polar.Sweep
+}
+
+// frame returns the center and the outer radius for canvas size.
+func (polar *Polar) frame(size Point) (center Point, outer Length) {
+	return size.Scale(0.5), math.Max(0, math.Min(size.X, size.Y)*0.5)
+}
+
+// angle converts a value on the X axis to an angle.
+func (polar *Polar) angle(x *Axis, v float64) float64 {
+	return polar.Start + polar.sweep()*x.ToCanvas(v, 0, 1)
+}
+
+// radius converts a value on the Y axis to a radius.
+func (polar *Polar) radius(y *Axis, v float64, outer Length) Length {
+	u := y.ToCanvas(v, 0, 1)
+	if y.Flip {
+		u = 1 - u
+	}
+	return outer * (polar.Hole + (1-polar.Hole)*u)
+}
+
+// polarPoint returns the canvas point at angle and radius from center.
+func polarPoint(center Point, angle float64, radius Length) Point {
+	sin, cos := math.Sincos(angle)
+	return Point{center.X + sin*radius, center.Y - cos*radius}
+}
+
+// ToCanvas converts a data point to canvas space of the specified size.
+func (polar *Polar) ToCanvas(x, y *Axis, p Point, size Point) Point {
+	center, outer := polar.frame(size)
+	return polarPoint(center, polar.angle(x, p.X), polar.radius(y, p.Y, outer))
+}
+
+// FromCanvas converts a canvas point to data space.
+func (polar *Polar) FromCanvas(x, y *Axis, p Point, size Point) Point {
+	center, outer := polar.frame(size)
+	d := p.Sub(center)
+
+	angle := math.Mod(math.Atan2(d.X, -d.Y)-polar.Start, 2*math.Pi)
+	if polar.sweep() > 0 && angle < 0 {
+		angle += 2 * math.Pi
+	} else if polar.sweep() < 0 && angle > 0 {
+		angle -= 2 * math.Pi
+	}
+
+	r := 0.0
+	if outer > 0 && polar.Hole < 1 {
+		r = (math.Hypot(d.X, d.Y)/outer - polar.Hole) / (1 - polar.Hole)
+	}
+	if y.Flip {
+		r = 1 - r
+	}
+
+	return Point{
+		X: x.FromCanvas(angle/polar.sweep(), 0, 1),
+		Y: y.FromCanvas(r, 0, 1),
+	}
+}
+
+// arc creates an arc around center at radius covering the X axis.
+func (polar *Polar) arc(center Point, radius Length) *Path {
+	path := &Path{}
+	path.MoveTo(polarPoint(center, polar.Start, radius))
+	path.ArcTo(center, polar.sweep())
+	return path
+}
+
+// sector creates a closed path between the inner and outer radius covering the X axis.
+func (polar *Polar) sector(center Point, inner, outer Length) *Path {
+	return wedge(center, polar.Start, polar.sweep(), inner, outer)
+}
+
+// wedge creates a closed path between the inner and outer radius,
+// starting at angle start and turning sweep.
+func wedge(center Point, start, sweep float64, inner, outer Length) *Path {
+	path := &Path{}
+	path.MoveTo(polarPoint(center, start, outer))
+	path.ArcTo(center, sweep)
+	if inner > 0 {
+		path.LineTo(polarPoint(center, start+sweep, inner))
+		path.ArcTo(center, -sweep)
+	} else if math.Abs(sweep) < 2*math.Pi {
+		path.LineTo(center)
+	}
+	path.Close()
+	return path
+}
+
+// polar returns the polar coordinate mode of the plot or the default one.
+func (plot *Plot) polar() *Polar {
+	if plot.Polar != nil {
+		return plot.Polar
+	}
+	return &Polar{}
+}
+
+// toCanvas converts a data point to canvas space of the specified size.
+func (plot *Plot) toCanvas(p Point, size Point) Point {
+	if plot.Polar != nil {
+		return plot.Polar.ToCanvas(plot.X, plot.Y, p, size)
+	}
+	return Point{
+		X: plot.X.ToCanvas(p.X, 0, size.X),
+		Y: plot.Y.ToCanvas(p.Y, 0, size.Y),
+	}
+}
+
+// project projects points to canvas space using the coordinate mode of the plot.
+func (plot *Plot) project(data []Point, bounds Rect) []Point {
+	if plot.Polar == nil {
+		return project(data, plot.X, plot.Y, bounds)
+	}
+	points := make([]Point, 0, len(data))
+	size := bounds.Size()
+	for _, p := range data {
+		points = append(points, plot.Polar.ToCanvas(plot.X, plot.Y, p, size))
+	}
+	return points
+}
+
+// PolarGrid implements a polar grid background with rings for the radius
+// and spokes for the angle.
+type PolarGrid struct {
+	GridTheme
+}
+
+// NewPolarGrid creates a new polar grid.
+func NewPolarGrid() *PolarGrid {
+	return &PolarGrid{}
+}
+
+// Draw draws the element to canvas.
+func (grid *PolarGrid) Draw(plot *Plot, canvas Canvas) {
+	x, y := plot.X, plot.Y
+	polar := plot.polar()
+
+	center, outer := polar.frame(canvas.Bounds().Size())
+	inner := outer * polar.Hole
+
+	theme := &grid.GridTheme
+	if theme.IsZero() {
+		theme = &plot.Theme.Grid
+	}
+
+	DrawPath(canvas, polar.sector(center, inner, outer), &Style{
+		Fill:  theme.Fill,
+		Class: "grid-fill",
+	})
+
+	major := &Style{
+		Size:   1,
+		Stroke: theme.Major,
+		Class:  "grid-major",
+	}
+	minor := &Style{
+		Size:   1,
+		Stroke: theme.Minor,
+		Class:  "grid-minor",
+	}
+	styleOf := func(tick Tick) *Style {
+		if tick.Minor {
+			return minor
+		}
+		return major
+	}
+
+	const epsilon = 1e-6
+	for _, tick := range y.Ticks.Ticks(y) {
+		r := polar.radius(y, tick.Value, outer)
+		if r < inner-epsilon || r > outer+epsilon || r <= 0 {
+			continue
+		}
+		DrawPath(canvas, polar.arc(center, r), styleOf(tick))
+	}
+
+	for _, tick := range x.Ticks.Ticks(x) {
+		u := x.ToCanvas(tick.Value, 0, 1)
+		if u < -epsilon || u > 1+epsilon {
+			continue
+		}
+		if polar.full() && math.Abs(u-1) < epsilon {
+			// coincides with the low end
+			continue
+		}
+		angle := polar.angle(x, tick.Value)
+		canvas.Poly([]Point{
+			polarPoint(center, angle, inner),
+			polarPoint(center, angle, outer),
+		}, styleOf(tick))
+	}
+}
+
+// PolarTickLabels implements drawing tick labels in polar coordinates.
+//
+// The angle labels are drawn around the outer ring and
+// the radius labels along the start of the angle axis.
+type PolarTickLabels struct {
+	Angle, Radius bool
+	Style         Style
+}
+
+// NewPolarTickLabels creates tick labels for both polar axes.
+func NewPolarTickLabels() *PolarTickLabels {
+	return &PolarTickLabels{
+		Angle:  true,
+		Radius: true,
+	}
+}
+
+// polarLabel is a label with its own origin.
+type polarLabel struct {
+	text  string
+	at    Point
+	style Style
+}
+
+// place calculates the labels to draw.
+func (labels *PolarTickLabels) place(plot *Plot, size Point) []polarLabel {
+	x, y := plot.X, plot.Y
+	polar := plot.polar()
+	center, outer := polar.frame(size)
+
+	base := labels.Style
+	if base.IsZero() {
+		base = plot.Theme.FontSmall
+	}
+
+	var placed []polarLabel
+	if labels.Angle {
+		for _, tick := range x.Ticks.Ticks(x) {
+			u := x.ToCanvas(tick.Value, 0, 1)
+			if tick.Label == "" || u < 0 || u > 1 || (polar.full() && u >= 1) {
+				continue
+			}
+			angle := polar.angle(x, tick.Value)
+			style := base
+			style.Origin = polarLabelOrigin(angle)
+			placed = append(placed, polarLabel{
+				text:  tick.Label,
+				at:    polarPoint(center, angle, outer+defaultLabelSpacing),
+				style: style,
+			})
+		}
+	}
+
+	if labels.Radius {
+		// the labels are placed beside the start spoke, away from the angle axis
+		side := polar.Start - math.Copysign(math.Pi/2, polar.sweep())
+		offset := polarPoint(Point{}, side, defaultLabelSpacing)
+		for _, tick := range y.Ticks.Ticks(y) {
+			if tick.Label == "" || tick.Minor {
+				continue
+			}
+			style := base
+			style.Origin = polarLabelOrigin(side)
+			placed = append(placed, polarLabel{
+				text:  tick.Label,
+				at:    polarPoint(center, polar.Start, polar.radius(y, tick.Value, outer)).Add(offset),
+				style: style,
+			})
+		}
+	}
+
+	return placed
+}
+
+// polarLabelOrigin returns the text origin such that the text
+// extends away from the center in the direction of angle.
+func polarLabelOrigin(angle float64) Point {
+	const threshold = 0.2
+	sin, cos := math.Sincos(angle)
+	origin := Point{}
+	if sin > threshold {
+		origin.X = -1
+	} else if sin < -threshold {
+		origin.X = 1
+	}
+	if cos > threshold {
+		origin.Y = 1
+	} else if cos < -threshold {
+		origin.Y = -1
+	}
+	return origin
+}
+
+// Draw draws tick labels to canvas using axes from plot.
+func (labels *PolarTickLabels) Draw(plot *Plot, canvas Canvas) {
+	for _, label := range labels.place(plot, canvas.Bounds().Size()) {
+		canvas.Text(label.text, label.at, &label.style)
+	}
+}
+
+// Overflow calculates how much the labels extend beyond bounds on each side.
+func (labels *PolarTickLabels) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	var overflow Rect
+	for _, label := range labels.place(plot, bounds.Size()) {
+		r := measurer.MeasureText(label.text, &label.style).Offset(label.at.Add(bounds.Min))
+		overflow = maxInsets(overflow, overflowOf(bounds, r))
+	}
+	return overflow
+}
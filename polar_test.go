@@ -0,0 +1,50 @@
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+func newPolarTestPlot() *Plot {
+	p := New()
+	p.Polar = &Polar{Hole: 0.2}
+	p.X.Min, p.X.Max = 0, 4
+	p.Y.Min, p.Y.Max = 0, 10
+	return p
+}
+
+func TestRadarMissingValues(t *testing.T) {
+	p := newPolarTestPlot()
+	radar := NewRadar("a", "b", "c", "d")
+	series := radar.Add("", 5, math.NaN(), 10)
+
+	size := Point{200, 100}
+	center, outer := p.Polar.frame(size)
+	points := radar.points(p, series, size)
+	for _, i := range []int{1, 3} {
+		d := points[i].Sub(center)
+		if r := math.Hypot(d.X, d.Y); math.Abs(r-outer*0.2) > 1e-6 {
+			t.Errorf("missing value %d: got radius %v, expected %v", i, r, outer*0.2)
+		}
+	}
+}
+
+func TestPolarHLine(t *testing.T) {
+	p := newPolarTestPlot()
+	canvas := &polyCanvas{bounds: R(0, 0, 200, 100)}
+	NewHLine(5, "").Draw(p, canvas)
+
+	center, outer := p.Polar.frame(canvas.bounds.Size())
+	expected := p.Polar.radius(p.Y, 5, outer)
+	if len(canvas.polys) == 0 {
+		t.Fatal("nothing drawn")
+	}
+	for _, poly := range canvas.polys {
+		for _, point := range poly {
+			d := point.Sub(center)
+			if r := math.Hypot(d.X, d.Y); math.Abs(r-expected) > 0.5 {
+				t.Fatalf("got point %v at radius %v, expected %v", point, r, expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package plot
+
+import "math"
+
+// RadarSeries is a single closed polygon of a radar chart.
+type RadarSeries struct {
+	Style
+	Label string
+	// Values are indexed by the spoke.
+	Values []float64
+}
+
+// Radar implements a radar chart, where each series is drawn as
+// a closed polygon over named spokes.
+//
+// The spokes are placed evenly around the polar coordinate mode of the plot
+// and the values use the Y axis as the radius. Use Axis as the plot X axis,
+// such that the polar grid and labels match the spokes.
+type Radar struct {
+	Spokes []string
+	Series []*RadarSeries
+}
+
+// NewRadar creates a radar chart with the named spokes.
+func NewRadar(spokes ...string) *Radar {
+	return &Radar{Spokes: spokes}
+}
+
+// Add adds a series with a value for each spoke.
+func (radar *Radar) Add(label string, values ...float64) *RadarSeries {
+	series := &RadarSeries{Label: label, Values: values}
+	radar.Series = append(radar.Series, series)
+	return series
+}
+
+// Axis creates an angle axis with a tick for each spoke.
+func (radar *Radar) Axis() *Axis {
+	axis := NewAxis()
+	axis.Min, axis.Max = 0, float64(len(radar.Spokes))
+	axis.MinorTicks = 0
+
+	ticks := make(ManualTicks, 0, len(radar.Spokes))
+	for i, spoke := range radar.Spokes {
+		ticks = append(ticks, Tick{Value: float64(i), Label: spoke})
+	}
+	axis.Ticks = ticks
+	return axis
+}
+
+// angleAxis returns the axis for the spokes, following the direction of the plot.
+func (radar *Radar) angleAxis(plot *Plot) *Axis {
+	axis := radar.Axis()
+	axis.Flip = plot.X.Flip
+	return axis
+}
+
+// Stats calculates element statistics.
+func (radar *Radar) Stats() Stats {
+	min, max := 0.0, math.NaN()
+	for _, series := range radar.Series {
+		for _, v := range series.Values {
+			if !isFinite(v) {
+				continue
+			}
+			min = math.Min(min, v)
+			if math.IsNaN(max) || v > max {
+				max = v
+			}
+		}
+	}
+	if len(radar.Spokes) == 0 || math.IsNaN(max) {
+		return nanStats
+	}
+
+	n := float64(len(radar.Spokes))
+	return Stats{
+		Min:    Point{0, min},
+		Center: Point{n * 0.5, (min + max) * 0.5},
+		Max:    Point{n, max},
+	}
+}
+
+// points returns the polygon of the series in canvas space,
+// missing values are drawn at the inner radius.
+func (radar *Radar) points(plot *Plot, series *RadarSeries, size Point) []Point {
+	polar, angle := plot.polar(), radar.angleAxis(plot)
+	center, outer := polar.frame(size)
+	points := make([]Point, 0, len(radar.Spokes)+1)
+	for i := range radar.Spokes {
+		if i >= len(series.Values) || !isFinite(series.Values[i]) {
+			points = append(points, polarPoint(center, polar.angle(angle, float64(i)), outer*polar.Hole))
+			continue
+		}
+		points = append(points, polar.ToCanvas(angle, plot.Y, Point{float64(i), series.Values[i]}, size))
+	}
+	return points
+}
+
+// Draw draws the element to canvas.
+func (radar *Radar) Draw(plot *Plot, canvas Canvas) {
+	if len(radar.Spokes) == 0 {
+		return
+	}
+	canvas = canvas.Clip(canvas.Bounds())
+	size := canvas.Bounds().Size()
+
+	for _, series := range radar.Series {
+		style := &series.Style
+		if style.IsZero() {
+			style = plot.autoStyle(series, &plot.Theme.Area)
+		}
+
+		points := radar.points(plot, series, size)
+		points = append(points, points[0])
+		canvas.Poly(points, plot.resolveStyle(style, canvas.Bounds()))
+	}
+}
+
+// HitTest finds the nearest series value to at, when the element is drawn to bounds.
+func (radar *Radar) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	if len(radar.Spokes) == 0 || math.IsNaN(at.X) || math.IsNaN(at.Y) {
+		return Hit{}, false
+	}
+
+	size := bounds.Size()
+	local := at.Sub(bounds.Min)
+
+	best := Hit{Distance: math.Inf(1)}
+	for _, series := range radar.Series {
+		for i, p := range radar.points(plot, series, size) {
+			if i >= len(series.Values) || !isFinite(series.Values[i]) {
+				continue
+			}
+			distance := hitDistance(p, local)
+			if distance >= best.Distance {
+				continue
+			}
+
+			label := radar.Spokes[i]
+			if series.Label != "" {
+				label = series.Label + ": " + label
+			}
+			best = Hit{
+				Element:  radar,
+				Label:    label,
+				Index:    i,
+				Value:    Point{float64(i), series.Values[i]},
+				Position: p.Add(bounds.Min),
+				Distance: distance,
+			}
+		}
+	}
+
+	return best, best.Element != nil
+}
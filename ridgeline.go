@@ -0,0 +1,298 @@
+package plot
+
+import (
+	"image/color"
+	"math"
+	"sort"
+)
+
+// RidgeOrder determines the order of groups in a ridgeline plot.
+type RidgeOrder byte
+
+const (
+	// RidgeInsertion keeps the order the groups were added in.
+	RidgeInsertion RidgeOrder = iota
+	// RidgeByLabel sorts the groups by label.
+	RidgeByLabel
+	// RidgeByMedian sorts the groups by median value.
+	RidgeByMedian
+)
+
+// RidgeGroup is a single row in a ridgeline plot.
+type RidgeGroup struct {
+	Style
+	Label string
+	Data  []float64 // sorted
+}
+
+// median returns the median of the group data.
+func (group *RidgeGroup) median() float64 {
+	if len(group.Data) == 0 {
+		return math.NaN()
+	}
+	return group.Data[len(group.Data)/2]
+}
+
+// Ridgeline implements a ridgeline plot (joyplot), which draws
+// densities of multiple groups in overlapping rows sharing the X axis.
+type Ridgeline struct {
+	Groups []*RidgeGroup
+
+	Kernel Length
+	// Normalized scales each ridge to the same height, otherwise
+	// the ridges use a shared scale.
+	Normalized bool
+	// Overlap is how much a ridge may extend into the rows above,
+	// relative to the row height.
+	Overlap float64
+
+	Order RidgeOrder
+	// Reverse reverses the order of the groups.
+	Reverse bool
+
+	// Labels draws group labels left of the rows.
+	Labels     bool
+	LabelStyle Style
+}
+
+// NewRidgeline creates a new ridgeline plot.
+func NewRidgeline() *Ridgeline {
+	return &Ridgeline{
+		Kernel:     math.NaN(),
+		Normalized: true,
+		Overlap:    1,
+		Labels:     true,
+	}
+}
+
+// Add adds a new group with the specified values.
+func (ridge *Ridgeline) Add(label string, values []float64) *RidgeGroup {
+	data := append(values[:0:0], values...)
+	sort.Float64s(data)
+	group := &RidgeGroup{
+		Label: label,
+		Data:  data,
+	}
+	ridge.Groups = append(ridge.Groups, group)
+	return group
+}
+
+// ordered returns the groups from top to bottom.
+func (ridge *Ridgeline) ordered() []*RidgeGroup {
+	groups := append(ridge.Groups[:0:0], ridge.Groups...)
+	switch ridge.Order {
+	case RidgeByLabel:
+		sort.SliceStable(groups, func(i, k int) bool {
+			return groups[i].Label < groups[k].Label
+		})
+	case RidgeByMedian:
+		sort.SliceStable(groups, func(i, k int) bool {
+			return groups[i].median() < groups[k].median()
+		})
+	}
+	if ridge.Reverse {
+		for i, k := 0, len(groups)-1; i < k; i, k = i+1, k-1 {
+			groups[i], groups[k] = groups[k], groups[i]
+		}
+	}
+	return groups
+}
+
+// Stats calculates element statistics.
+func (ridge *Ridgeline) Stats() Stats {
+	min, max := math.NaN(), math.NaN()
+	for _, group := range ridge.Groups {
+		n := len(group.Data)
+		if n == 0 {
+			continue
+		}
+		if math.IsNaN(min) || group.Data[0] < min {
+			min = group.Data[0]
+		}
+		if math.IsNaN(max) || group.Data[n-1] > max {
+			max = group.Data[n-1]
+		}
+	}
+
+	return Stats{
+		Min:    Point{min, 0},
+		Center: Point{(min + max) * 0.5, 0.5},
+		Max:    Point{max, 1},
+	}
+}
+
+// rowHeight returns the height of a single row.
+func (ridge *Ridgeline) rowHeight(size Point, n int) Length {
+	// the topmost ridge must fit into the bounds
+	return size.Y / (float64(n) + math.Max(ridge.Overlap, 0))
+}
+
+// baseline returns the canvas position of the i-th row baseline.
+func (ridge *Ridgeline) baseline(size Point, n, i int) Length {
+	return size.Y - float64(n-1-i)*ridge.rowHeight(size, n)
+}
+
+// labelStyle returns the style for group labels.
+func (ridge *Ridgeline) labelStyle(plot *Plot) *Style {
+	style := ridge.LabelStyle
+	if style.IsZero() {
+		style = plot.Theme.FontSmall
+	}
+	style.Origin = Point{1, 0}
+	return &style
+}
+
+// Draw draws the element to canvas.
+func (ridge *Ridgeline) Draw(plot *Plot, canvas Canvas) {
+	groups := ridge.ordered()
+	if len(groups) == 0 {
+		return
+	}
+
+	x := plot.X
+	size := canvas.Bounds().Size()
+	height := ridge.rowHeight(size, len(groups))
+	peak := height * (1 + math.Max(ridge.Overlap, 0))
+
+	kernel := ridge.Kernel
+	if math.IsNaN(kernel) {
+		// default to 4px wide kernel
+		kernel = 4 * (x.Max - x.Min) / size.X
+	}
+
+	samples := make([][]Point, len(groups))
+	scales := make([]float64, len(groups))
+	shared := 0.0
+	for i, group := range groups {
+		var maxy float64
+		samples[i], maxy = sampleDensity(group.Data, x, size.X, kernel)
+		if ridge.Normalized {
+			if maxy > 0 {
+				scales[i] = 1 / maxy
+			}
+		} else if len(group.Data) > 0 {
+			scales[i] = kernel / float64(len(group.Data))
+			shared = math.Max(shared, maxy*scales[i])
+		}
+	}
+
+	// draw from top to bottom, so that lower ridges cover the upper ones
+	for i, group := range groups {
+		base := ridge.baseline(size, len(groups), i)
+
+		scale := scales[i]
+		if !ridge.Normalized && shared > 0 {
+			scale /= shared
+		}
+
+		upper := samples[i]
+		if len(upper) == 0 {
+			continue
+		}
+		for k := range upper {
+			upper[k].Y = base - upper[k].Y*scale*peak
+		}
+		lower := []Point{{upper[0].X, base}, {upper[len(upper)-1].X, base}}
+
+		style := &group.Style
+		if style.IsZero() {
+			style = plot.autoStyle(group, &plot.Theme.Area)
+		}
+		style = plot.resolveStyle(style, canvas.Bounds())
+		if style.HasFill() {
+			// hide the ridges behind
+			if plot.Theme.Background != nil {
+				drawArea(canvas, upper, lower, &Style{Fill: plot.Theme.Background})
+			} else {
+				style = opaqueFill(style)
+			}
+		}
+		drawArea(canvas, upper, lower, style)
+
+		if ridge.Labels && group.Label != "" {
+			canvas.Text(group.Label, Point{-defaultLabelSpacing, base}, ridge.labelStyle(plot))
+		}
+	}
+}
+
+// opaqueFill returns style with the fill composited over white,
+// such that it looks the same on white background and hides the shapes behind.
+func opaqueFill(style *Style) *Style {
+	opaque := *style
+	if style.Fill != nil {
+		opaque.Fill = overWhite(style.Fill)
+	}
+	if style.FillGradient != nil {
+		gradient := *style.FillGradient
+		gradient.Stops = make([]GradientStop, len(style.FillGradient.Stops))
+		for i, stop := range style.FillGradient.Stops {
+			gradient.Stops[i] = GradientStop{Offset: stop.Offset, Color: overWhite(stop.Color)}
+		}
+		opaque.FillGradient = &gradient
+	}
+	return &opaque
+}
+
+// overWhite composites c over white.
+func overWhite(c color.Color) color.Color {
+	r, g, b, a := c.RGBA()
+	white := 0xffff - a
+	return color.RGBA64{
+		R: uint16(r + white),
+		G: uint16(g + white),
+		B: uint16(b + white),
+		A: 0xffff,
+	}
+}
+
+// Overflow calculates how much the labels extend beyond bounds on each side.
+func (ridge *Ridgeline) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	if !ridge.Labels {
+		return Rect{}
+	}
+
+	groups := ridge.ordered()
+	size := bounds.Size()
+	style := ridge.labelStyle(plot)
+
+	var overflow Rect
+	for i, group := range groups {
+		if group.Label == "" {
+			continue
+		}
+		at := Point{-defaultLabelSpacing, ridge.baseline(size, len(groups), i)}
+		r := measurer.MeasureText(group.Label, style).Offset(at.Add(bounds.Min))
+		overflow = maxInsets(overflow, overflowOf(bounds, r))
+	}
+	return overflow
+}
+
+// HitTest finds the group under at, when the element is drawn to bounds.
+func (ridge *Ridgeline) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	groups := ridge.ordered()
+	if len(groups) == 0 || math.IsNaN(at.X) || math.IsNaN(at.Y) {
+		return Hit{}, false
+	}
+
+	size := bounds.Size()
+	local := at.Sub(bounds.Min)
+
+	// find the row whose baseline is nearest below at
+	height := ridge.rowHeight(size, len(groups))
+	index := len(groups) - 1 - int(math.Floor((size.Y-local.Y)/height))
+	if index < 0 || index >= len(groups) {
+		return Hit{}, false
+	}
+
+	group := groups[index]
+	value := plot.X.FromCanvas(local.X, 0, size.X)
+	position := Point{local.X, ridge.baseline(size, len(groups), index)}
+	return Hit{
+		Element:  ridge,
+		Label:    group.Label,
+		Index:    index,
+		Value:    Point{value, group.median()},
+		Position: position.Add(bounds.Min),
+		Distance: hitDistance(position, local),
+	}, true
+}
@@ -0,0 +1,99 @@
+package plot
+
+import (
+	"image/color"
+	"math"
+	"testing"
+)
+
+func TestRidgelineOffsets(t *testing.T) {
+	tests := []struct {
+		name      string
+		groups    int
+		overlap   float64
+		baselines []Length
+	}{
+		{"single", 1, 0, []Length{100}},
+		{"single overlapping", 1, 1, []Length{100}},
+		{"overlapping", 4, 1, []Length{40, 60, 80, 100}},
+		{"half overlap", 3, 0.5, []Length{300 / 7.0, 500 / 7.0, 100}},
+		{"negative overlap", 2, -1, []Length{50, 100}},
+	}
+
+	size := Point{200, 100}
+	for _, test := range tests {
+		ridge := NewRidgeline()
+		ridge.Overlap = test.overlap
+		height := ridge.rowHeight(size, test.groups)
+
+		// the topmost ridge peak must fit into the bounds
+		if top := ridge.baseline(size, test.groups, 0) - height*(1+math.Max(test.overlap, 0)); math.Abs(top) > 1e-9 {
+			t.Errorf("%s: got the top peak at %v, expected 0", test.name, top)
+		}
+		for i, expected := range test.baselines {
+			if got := ridge.baseline(size, test.groups, i); math.Abs(got-expected) > 1e-9 {
+				t.Errorf("%s: got baseline %d at %v, expected %v", test.name, i, got, expected)
+			}
+		}
+	}
+}
+
+func TestRidgelineHidesRidgesBehind(t *testing.T) {
+	tests := []struct {
+		name       string
+		background color.Color
+		fills      int
+	}{
+		{"background", color.NRGBA{30, 30, 30, 255}, 4},
+		{"transparent", nil, 2},
+	}
+
+	for _, test := range tests {
+		p := New()
+		p.X.Min, p.X.Max = 0, 10
+		p.Y.Min, p.Y.Max = 0, 1
+		p.Theme.Background = test.background
+		p.Theme.Area.Fill = color.NRGBA{0, 0, 200, 100}
+
+		ridge := NewRidgeline()
+		ridge.Add("a", []float64{2, 3, 4})
+		ridge.Add("b", []float64{5, 6, 7})
+
+		canvas := &styleCanvas{polyCanvas: polyCanvas{bounds: R(0, 0, 200, 100)}}
+		ridge.Draw(p, canvas)
+
+		var fills []color.Color
+		for _, style := range canvas.styles {
+			if style.Fill != nil {
+				fills = append(fills, style.Fill)
+			}
+		}
+		if len(fills) != test.fills {
+			t.Fatalf("%s: got %d fills, expected %d", test.name, len(fills), test.fills)
+		}
+
+		// the first fill of each ridge must be opaque
+		for i := 0; i < len(fills); i += len(fills) / 2 {
+			if _, _, _, a := fills[i].RGBA(); a != 0xffff {
+				t.Errorf("%s: got fill %v, expected it to be opaque", test.name, fills[i])
+			}
+		}
+	}
+}
+
+func TestOverWhite(t *testing.T) {
+	tests := []struct {
+		in       color.Color
+		expected color.NRGBA
+	}{
+		{color.NRGBA{0, 0, 0, 255}, color.NRGBA{0, 0, 0, 255}},
+		{color.NRGBA{0, 0, 0, 0}, color.NRGBA{255, 255, 255, 255}},
+		{color.NRGBA{0, 0, 255, 102}, color.NRGBA{153, 153, 255, 255}},
+	}
+
+	for _, test := range tests {
+		if got := color.NRGBAModel.Convert(overWhite(test.in)); got != test.expected {
+			t.Errorf("%v: got %v, expected %v", test.in, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package plot
+
+import (
+	"math"
+	"sort"
+)
+
+// LineSimplifier reduces the number of points in a line.
+//
+// The points are in canvas space, such that the simplification
+// can take the visual size into account.
+type LineSimplifier interface {
+	// Simplify returns a visually similar line, it may modify points.
+	// Non-finite points are kept as gaps between the simplified parts.
+	Simplify(points []Point) []Point
+}
+
+// simplifyRuns simplifies each run of finite points separately,
+// keeping the non-finite points that separate them.
+func simplifyRuns(points []Point, simplify func(run []Point) []Point) []Point {
+	gaps := false
+	for _, p := range points {
+		if !isFinitePoint(p) {
+			gaps = true
+			break
+		}
+	}
+	if !gaps {
+		return simplify(points)
+	}
+
+	result := make([]Point, 0, len(points))
+	start := 0
+	for i, p := range points {
+		if isFinitePoint(p) {
+			continue
+		}
+		result = append(result, simplify(points[start:i])...)
+		result = append(result, p)
+		start = i + 1
+	}
+	return append(result, simplify(points[start:])...)
+}
+
+// CollinearSimplifier drops points that are nearly on the line
+// between its neighbours.
+type CollinearSimplifier struct {
+	Threshold Length
+}
+
+// Simplify returns a visually similar line.
+func (simplifier CollinearSimplifier) Simplify(points []Point) []Point {
+	return simplifyRuns(points, simplifier.simplify)
+}
+
+// simplify simplifies a line without gaps.
+func (simplifier CollinearSimplifier) simplify(points []Point) []Point {
+	if len(points) < 3 {
+		return points
+	}
+
+	// always include the first point
+	optimized := points[:1]
+
+	prev := points[0]
+	mid := points[1]
+	for _, next := range points[2:] {
+		// does the mid change significantly from the previous?
+		if math.Abs(prev.Y-mid.Y) < simplifier.Threshold && math.Abs(prev.X-mid.X) < simplifier.Threshold {
+			mid = next
+			continue
+		}
+
+		// is the mid on the line from prev to next?
+		p := invlerp(mid.X, prev.X, next.X)
+		knownY := lerp(p, prev.Y, next.Y)
+		if math.Abs(knownY-mid.Y) < simplifier.Threshold {
+			mid = next
+			continue
+		}
+
+		// otherwise let's output
+		optimized = append(optimized, mid)
+		prev, mid = mid, next
+	}
+	// add the final point
+	return append(optimized, points[len(points)-1])
+}
+
+// LTTBSimplifier implements Largest-Triangle-Three-Buckets downsampling.
+type LTTBSimplifier struct {
+	// Count is the number of points in the result.
+	// When zero, it uses two points per pixel of line width.
+	Count int
+}
+
+// Simplify returns a visually similar line.
+//
+// With gaps, the count is divided between the parts by their length.
+func (simplifier LTTBSimplifier) Simplify(points []Point) []Point {
+	count := simplifier.Count
+	if count <= 0 {
+		stats := PointsStats(points)
+		count = int(math.Ceil(2 * (stats.Max.X - stats.Min.X)))
+	}
+	if count >= len(points) {
+		return points
+	}
+
+	total := len(points)
+	return simplifyRuns(points, func(run []Point) []Point {
+		return lttb(run, count*len(run)/total)
+	})
+}
+
+// lttb downsamples points without gaps to count points,
+// the first and last point are always kept.
+func lttb(points []Point, count int) []Point {
+	if count >= len(points) || len(points) <= 2 {
+		return points
+	}
+	if count <= 2 {
+		return []Point{points[0], points[len(points)-1]}
+	}
+
+	sampled := make([]Point, 0, count)
+	sampled = append(sampled, points[0])
+
+	every := float64(len(points)-2) / float64(count-2)
+	selected := 0
+	for i := 0; i < count-2; i++ {
+		// average of the next bucket
+		nextStart := int(float64(i+1)*every) + 1
+		nextEnd := int(float64(i+2)*every) + 1
+		if nextEnd > len(points) {
+			nextEnd = len(points)
+		}
+		var avg Point
+		for _, p := range points[nextStart:nextEnd] {
+			avg = avg.Add(p)
+		}
+		avg = avg.Scale(1 / float64(nextEnd-nextStart))
+
+		// point in the current bucket with the largest triangle
+		start := int(float64(i)*every) + 1
+		end := int(float64(i+1)*every) + 1
+
+		a := points[selected]
+		maxArea := -1.0
+		for k := start; k < end; k++ {
+			p := points[k]
+			area := math.Abs((a.X-avg.X)*(p.Y-a.Y) - (a.X-p.X)*(avg.Y-a.Y))
+			if area > maxArea {
+				maxArea = area
+				selected = k
+			}
+		}
+
+		sampled = append(sampled, points[selected])
+	}
+
+	return append(sampled, points[len(points)-1])
+}
+
+// MinMaxSimplifier keeps the first, last, minimum and maximum
+// point of each pixel column, which preserves spikes.
+type MinMaxSimplifier struct {
+	// Width is the width of a single column, defaults to 1px.
+	Width Length
+}
+
+// Simplify returns a visually similar line.
+func (simplifier MinMaxSimplifier) Simplify(points []Point) []Point {
+	return simplifyRuns(points, simplifier.simplify)
+}
+
+// simplify simplifies a line without gaps.
+func (simplifier MinMaxSimplifier) simplify(points []Point) []Point {
+	if len(points) < 5 {
+		return points
+	}
+
+	width := simplifier.Width
+	if width <= 0 {
+		width = 1
+	}
+
+	optimized := make([]Point, 0, len(points))
+	indices := make([]int, 0, 4)
+
+	flush := func(start, end int) {
+		low, high := start, start
+		for i := start; i < end; i++ {
+			if points[i].Y < points[low].Y {
+				low = i
+			}
+			if points[i].Y > points[high].Y {
+				high = i
+			}
+		}
+
+		indices = append(indices[:0], start, low, high, end-1)
+		sort.Ints(indices)
+		for i, index := range indices {
+			if i > 0 && indices[i-1] == index {
+				continue
+			}
+			optimized = append(optimized, points[index])
+		}
+	}
+
+	start := 0
+	column := math.Floor(points[0].X / width)
+	for i, p := range points {
+		if c := math.Floor(p.X / width); c != column {
+			flush(start, i)
+			start, column = i, c
+		}
+	}
+	flush(start, len(points))
+
+	return optimized
+}
+
+// RDPSimplifier implements Ramer-Douglas-Peucker line simplification.
+type RDPSimplifier struct {
+	// Epsilon is the maximum allowed distance from the simplified line.
+	Epsilon Length
+}
+
+// Simplify returns a visually similar line.
+func (simplifier RDPSimplifier) Simplify(points []Point) []Point {
+	return simplifyRuns(points, simplifier.simplify)
+}
+
+// simplify simplifies a line without gaps.
+func (simplifier RDPSimplifier) simplify(points []Point) []Point {
+	if len(points) < 3 {
+		return points
+	}
+
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+
+	type span struct{ start, end int }
+	stack := []span{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		farthest, maxDistance := -1, simplifier.Epsilon
+		for i := s.start + 1; i < s.end; i++ {
+			distance := segmentDistance(points[i], points[s.start], points[s.end])
+			if distance > maxDistance {
+				farthest, maxDistance = i, distance
+			}
+		}
+
+		if farthest >= 0 {
+			keep[farthest] = true
+			stack = append(stack, span{s.start, farthest}, span{farthest, s.end})
+		}
+	}
+
+	optimized := points[:0]
+	for i, p := range points {
+		if keep[i] {
+			optimized = append(optimized, p)
+		}
+	}
+	return optimized
+}
+
+// segmentDistance calculates distance from p to the line segment a-b.
+func segmentDistance(p, a, b Point) Length {
+	ab := b.Sub(a)
+	ap := p.Sub(a)
+
+	lengthSquared := ab.X*ab.X + ab.Y*ab.Y
+	if lengthSquared == 0 {
+		return math.Hypot(ap.X, ap.Y)
+	}
+
+	t := (ap.X*ab.X + ap.Y*ab.Y) / lengthSquared
+	t = math.Max(0, math.Min(1, t))
+	closest := a.Add(ab.Scale(t))
+	d := p.Sub(closest)
+	return math.Hypot(d.X, d.Y)
+}
@@ -0,0 +1,208 @@
+package plot
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var testSimplifiers = []struct {
+	name       string
+	simplifier LineSimplifier
+}{
+	{"collinear", CollinearSimplifier{Threshold: 1}},
+	{"lttb", LTTBSimplifier{Count: 10}},
+	{"minmax", MinMaxSimplifier{Width: 1}},
+	{"rdp", RDPSimplifier{Epsilon: 1}},
+}
+
+// randomWalk creates a line with n points spaced by dx.
+func randomWalk(n int, dx float64) []Point {
+	rng := rand.New(rand.NewSource(1))
+	points := make([]Point, n)
+	y := 0.0
+	for i := range points {
+		y += rng.Float64()*2 - 1
+		points[i] = Point{float64(i) * dx, y * 10}
+	}
+	return points
+}
+
+func clonePoints(points []Point) []Point {
+	return append([]Point(nil), points...)
+}
+
+func TestSimplifySmall(t *testing.T) {
+	inputs := [][]Point{
+		{},
+		{{0, 0}},
+		{{0, 0}, {1, 5}},
+		{{0, 0}, {1, 5}, {2, 0}},
+	}
+	for _, test := range testSimplifiers {
+		for _, input := range inputs {
+			got := test.simplifier.Simplify(clonePoints(input))
+			if len(input) <= 2 {
+				if !equalPoints(got, input) {
+					t.Errorf("%s: %v: got %v", test.name, input, got)
+				}
+				continue
+			}
+			if !keepsEnds(got, input) {
+				t.Errorf("%s: %v: got %v, expected first and last point", test.name, input, got)
+			}
+		}
+	}
+}
+
+func TestSimplifyKeepsEnds(t *testing.T) {
+	input := randomWalk(500, 0.1)
+	for _, test := range testSimplifiers {
+		got := test.simplifier.Simplify(clonePoints(input))
+		if !keepsEnds(got, input) {
+			t.Errorf("%s: first or last point missing", test.name)
+		}
+		if len(got) > len(input) {
+			t.Errorf("%s: got %d points from %d", test.name, len(got), len(input))
+		}
+	}
+}
+
+func TestLTTBCount(t *testing.T) {
+	input := randomWalk(500, 0.1)
+	for _, count := range []int{1, 2, 3, 10, 499, 500, 1000} {
+		got := LTTBSimplifier{Count: count}.Simplify(clonePoints(input))
+		limit := count
+		if limit < 2 {
+			limit = 2
+		}
+		if len(got) > limit {
+			t.Errorf("count %d: got %d points", count, len(got))
+		}
+		if !keepsEnds(got, input) {
+			t.Errorf("count %d: first or last point missing", count)
+		}
+	}
+}
+
+func TestMinMaxColumns(t *testing.T) {
+	// 500 points over 50 columns
+	input := randomWalk(500, 0.1)
+	for _, width := range []Length{1, 5} {
+		got := MinMaxSimplifier{Width: width}.Simplify(clonePoints(input))
+		columns := int(math.Ceil(50 / width))
+		if len(got) > 4*columns {
+			t.Errorf("width %v: got %d points, expected at most %d", width, len(got), 4*columns)
+		}
+	}
+}
+
+func TestRDPEpsilon(t *testing.T) {
+	tests := []struct {
+		deviation Length
+		keep      bool
+	}{
+		{0.5, false},
+		{1, false},
+		{1.001, true},
+		{2, true},
+	}
+	for _, test := range tests {
+		input := []Point{{0, 0}, {1, test.deviation}, {2, 0}}
+		got := RDPSimplifier{Epsilon: 1}.Simplify(input)
+		if kept := len(got) == 3; kept != test.keep {
+			t.Errorf("deviation %v: got %v", test.deviation, got)
+		}
+	}
+}
+
+func TestSimplifyGaps(t *testing.T) {
+	first, second := randomWalk(100, 0.1), randomWalk(100, 0.1)
+	for i := range second {
+		second[i].X += 20
+	}
+	gap := Point{math.NaN(), math.NaN()}
+
+	var input []Point
+	input = append(input, first...)
+	input = append(input, gap)
+	input = append(input, second...)
+
+	for _, test := range testSimplifiers {
+		got := test.simplifier.Simplify(clonePoints(input))
+
+		split := -1
+		for i, p := range got {
+			if !isFinitePoint(p) {
+				if split >= 0 {
+					t.Errorf("%s: multiple gaps", test.name)
+				}
+				split = i
+			}
+		}
+		if split < 0 {
+			t.Errorf("%s: gap missing", test.name)
+			continue
+		}
+		if !keepsEnds(got[:split], first) || !keepsEnds(got[split+1:], second) {
+			t.Errorf("%s: parts don't keep their first and last point", test.name)
+		}
+	}
+}
+
+func TestSimplifyKeepsExtremes(t *testing.T) {
+	// 2000 points over 200 pixels
+	input := randomWalk(2000, 0.1)
+	spike, trough := &input[700], &input[1300]
+	spike.Y, trough.Y = 1000, -1000
+	peak, valley := *spike, *trough
+
+	simplifiers := []struct {
+		name       string
+		simplifier LineSimplifier
+	}{
+		{"lttb", LTTBSimplifier{}},
+		{"minmax", MinMaxSimplifier{Width: 1}},
+		{"rdp", RDPSimplifier{Epsilon: 1}},
+	}
+	for _, test := range simplifiers {
+		got := test.simplifier.Simplify(clonePoints(input))
+		if !containsPoint(got, peak) {
+			t.Errorf("%s: spike %v missing", test.name, peak)
+		}
+		if !containsPoint(got, valley) {
+			t.Errorf("%s: trough %v missing", test.name, valley)
+		}
+		if len(got) >= len(input) {
+			t.Errorf("%s: got %d points from %d", test.name, len(got), len(input))
+		}
+	}
+}
+
+func containsPoint(points []Point, p Point) bool {
+	for _, q := range points {
+		if q == p {
+			return true
+		}
+	}
+	return false
+}
+
+func keepsEnds(got, input []Point) bool {
+	if len(got) < 2 {
+		return false
+	}
+	return got[0] == input[0] && got[len(got)-1] == input[len(input)-1]
+}
+
+func equalPoints(a, b []Point) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -59,24 +59,27 @@ func maximalStats(els []Element) Stats {
 	return finalstats
 }
 
-// PointsStats calculates statistics of points.
+// PointsStats calculates statistics of points, ignoring non-finite values.
 func PointsStats(points []Point) Stats {
 	min, avg, max := nanPoint, Point{}, nanPoint
 
-	if len(points) > 0 {
-		min = points[0]
-		max = points[0]
-	}
-
+	count := 0
 	for _, p := range points {
+		if !isFinitePoint(p) {
+			continue
+		}
+		if count == 0 {
+			min, max = p, p
+		}
 		min = min.Min(p)
 		avg = avg.Add(p)
 		max = max.Max(p)
+		count++
 	}
 
 	return Stats{
 		Min:    min,
-		Center: avg.Scale(1 / float64(len(points))),
+		Center: avg.Scale(1 / float64(count)),
 		Max:    max,
 	}
 }
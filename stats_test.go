@@ -0,0 +1,26 @@
+package plot
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPointsStatsMissing(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		name     string
+		points   []Point
+		expected Stats
+	}{
+		{"empty", nil, nanStats},
+		{"missing", []Point{{nan, 1}, {1, nan}, {math.Inf(-1), 0}}, nanStats},
+		{"finite", []Point{{0, 4}, {2, 0}}, Stats{Min: Point{0, 0}, Center: Point{1, 2}, Max: Point{2, 4}}},
+		{"mixed", []Point{{nan, 100}, {0, 4}, {5, nan}, {2, 0}, {math.Inf(1), 1}}, Stats{Min: Point{0, 0}, Center: Point{1, 2}, Max: Point{2, 4}}},
+	}
+
+	for _, test := range tests {
+		if got := PointsStats(test.points); !approxEqualStats(got, test.expected) {
+			t.Errorf("%s: got %v, expected %v", test.name, got, test.expected)
+		}
+	}
+}
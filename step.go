@@ -0,0 +1,46 @@
+package plot
+
+// StepMode determines how a line is drawn between consecutive points.
+type StepMode byte
+
+const (
+	// StepNone draws straight lines between points.
+	StepNone StepMode = iota
+	// StepPre changes the value at the start of the interval,
+	// i.e. the line is drawn vertically first.
+	StepPre
+	// StepPost changes the value at the end of the interval,
+	// i.e. the line is drawn horizontally first.
+	StepPost
+	// StepMid changes the value at the middle of the interval.
+	StepMid
+)
+
+// expand adds the intermediate points for drawing steps.
+func (mode StepMode) expand(data []Point) []Point {
+	if mode == StepNone || len(data) < 2 {
+		return data
+	}
+
+	points := make([]Point, 0, len(data)*3)
+	points = append(points, data[0])
+	for i, next := range data[1:] {
+		prev := data[i]
+		if !isFinitePoint(prev) || !isFinitePoint(next) {
+			points = append(points, next)
+			continue
+		}
+
+		switch mode {
+		case StepPre:
+			points = append(points, Point{prev.X, next.Y})
+		case StepPost:
+			points = append(points, Point{next.X, prev.Y})
+		case StepMid:
+			mid := (prev.X + next.X) * 0.5
+			points = append(points, Point{mid, prev.Y}, Point{mid, next.Y})
+		}
+		points = append(points, next)
+	}
+	return points
+}
@@ -0,0 +1,337 @@
+package plot
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Stream implements a fixed capacity time-series buffer for live plots.
+//
+// Values can be added concurrently from multiple goroutines, while
+// the plot is being drawn. When the buffer is full the oldest values
+// are overwritten.
+type Stream struct {
+	Style
+	Label string
+
+	// Epoch is the time that corresponds to X = 0 when using AddTime.
+	Epoch time.Time
+	// Window limits the view to the last Window units of X.
+	// When using AddTime, X is measured in seconds, so 30 means the last 30s.
+	// Zero means all values in the buffer are used.
+	//
+	// X is expected to be non-decreasing. Otherwise the window is found
+	// with a linear scan and starts after the newest value outside of it.
+	Window float64
+	// Gaps determines how missing values are drawn.
+	Gaps GapMode
+	// Step determines whether the line is drawn as a staircase.
+	Step StepMode
+
+	mu    sync.Mutex
+	data  []Point
+	start int
+	count int
+
+	// seq is the number of values ever added, it identifies the values in the extremes.
+	seq uint64
+	// prefix contains the running totals up to and including each value,
+	// evicted is the running total up to the oldest value.
+	// The totals are restarted from the buffer contents whenever the
+	// buffer wraps around, such that they don't accumulate rounding errors.
+	prefix  []streamTotal
+	total   streamTotal
+	evicted streamTotal
+	// descending marks the values with a smaller X than the previous value,
+	// descents is the number of marked values in the buffer.
+	descending []bool
+	descents   int
+	// minX, maxX, minY and maxY track the extremes of the finite values.
+	minX, maxX extremes
+	minY, maxY extremes
+
+	drawMu   sync.Mutex
+	snapshot []Point
+}
+
+// streamTotal is a running total of the finite values.
+type streamTotal struct {
+	sum    Point
+	finite int
+}
+
+// NewStream creates a new stream with the specified capacity.
+func NewStream(label string, capacity int) *Stream {
+	if capacity <= 0 {
+		panic("capacity must be positive")
+	}
+	return &Stream{
+		Label:      label,
+		Epoch:      time.Now(),
+		data:       make([]Point, capacity),
+		prefix:     make([]streamTotal, capacity),
+		descending: make([]bool, capacity),
+		maxX:       extremes{max: true},
+		maxY:       extremes{max: true},
+	}
+}
+
+// Capacity returns the maximum number of values in the stream.
+func (stream *Stream) Capacity() int { return len(stream.data) }
+
+// Len returns the number of values in the stream.
+func (stream *Stream) Len() int {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+	return stream.count
+}
+
+// Add adds a new value to the stream, overwriting the oldest value when full.
+func (stream *Stream) Add(x, y float64) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	p := Point{x, y}
+	n := len(stream.data)
+	wrapped := false
+	if stream.count == n {
+		stream.evicted = stream.prefix[stream.start]
+		stream.start = (stream.start + 1) % n
+		stream.count--
+		if stream.count > 0 {
+			stream.setDescending(stream.start, false)
+		}
+		wrapped = stream.start == 0
+	}
+
+	descending := stream.count > 0 && !(x >= stream.at(stream.count-1).X)
+	slot := (stream.start + stream.count) % n
+	stream.count++
+	stream.setDescending(slot, descending)
+	stream.data[slot] = p
+	if isFinitePoint(p) {
+		stream.total.sum = stream.total.sum.Add(p)
+		stream.total.finite++
+		stream.minX.push(stream.seq, p.X)
+		stream.maxX.push(stream.seq, p.X)
+		stream.minY.push(stream.seq, p.Y)
+		stream.maxY.push(stream.seq, p.Y)
+	}
+	stream.prefix[slot] = stream.total
+	stream.seq++
+
+	oldest := stream.seq - uint64(stream.count)
+	stream.minX.evict(oldest)
+	stream.maxX.evict(oldest)
+	stream.minY.evict(oldest)
+	stream.maxY.evict(oldest)
+
+	if wrapped {
+		stream.rebase()
+	}
+}
+
+// setDescending marks whether the value at slot is descending, must be called with mu held.
+func (stream *Stream) setDescending(slot int, descending bool) {
+	if stream.descending[slot] == descending {
+		return
+	}
+	stream.descending[slot] = descending
+	if descending {
+		stream.descents++
+	} else {
+		stream.descents--
+	}
+}
+
+// rebase restarts the running totals from the values in the buffer, must be called with mu held.
+func (stream *Stream) rebase() {
+	var total streamTotal
+	for i := 0; i < stream.count; i++ {
+		p := stream.at(i)
+		if isFinitePoint(p) {
+			total.sum = total.sum.Add(p)
+			total.finite++
+		}
+		stream.prefix[(stream.start+i)%len(stream.data)] = total
+	}
+	stream.total, stream.evicted = total, streamTotal{}
+}
+
+// AddTime adds a new value at time t, which is converted to seconds since Epoch.
+func (stream *Stream) AddTime(t time.Time, y float64) {
+	stream.Add(t.Sub(stream.Epoch).Seconds(), y)
+}
+
+// Reset removes all values from the stream.
+func (stream *Stream) Reset() {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	stream.start, stream.count = 0, 0
+	stream.total, stream.evicted = streamTotal{}, streamTotal{}
+	for i := range stream.descending {
+		stream.descending[i] = false
+	}
+	stream.descents = 0
+	stream.minX.reset()
+	stream.maxX.reset()
+	stream.minY.reset()
+	stream.maxY.reset()
+}
+
+// at returns i-th oldest value, must be called with mu held.
+func (stream *Stream) at(i int) Point {
+	return stream.data[(stream.start+i)%len(stream.data)]
+}
+
+// windowStart returns the index of the first value in the window, must be called with mu held.
+func (stream *Stream) windowStart() int {
+	if stream.Window <= 0 || stream.count == 0 {
+		return 0
+	}
+	low := stream.at(stream.count-1).X - stream.Window
+	if stream.descents > 0 {
+		i := stream.count
+		for i > 0 && !(stream.at(i-1).X < low) {
+			i--
+		}
+		return i
+	}
+	return sort.Search(stream.count, func(i int) bool {
+		return stream.at(i).X >= low
+	})
+}
+
+// Snapshot appends a consistent copy of the values in the window to dst.
+func (stream *Stream) Snapshot(dst []Point) []Point {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	for i := stream.windowStart(); i < stream.count; i++ {
+		dst = append(dst, stream.at(i))
+	}
+	return dst
+}
+
+// Stats calculates statistics of the finite values in the window.
+func (stream *Stream) Stats() Stats {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if stream.count == 0 {
+		return nanStats
+	}
+
+	start := stream.windowStart()
+	before := stream.evicted
+	if start > 0 {
+		before = stream.prefix[(stream.start+start-1)%len(stream.data)]
+	}
+	finite := stream.total.finite - before.finite
+	if finite == 0 {
+		return nanStats
+	}
+	sum := stream.total.sum.Sub(before.sum)
+
+	seq := stream.seq - uint64(stream.count) + uint64(start)
+	return Stats{
+		Min:    Point{stream.minX.since(seq), stream.minY.since(seq)},
+		Center: sum.Scale(1 / float64(finite)),
+		Max:    Point{stream.maxX.since(seq), stream.maxY.since(seq)},
+	}
+}
+
+// extremes tracks the minimum or maximum of the latest values using
+// a monotonic queue, such that adding, evicting and querying are cheap.
+//
+// Each queued value is the extreme of the values added after the previous one.
+type extremes struct {
+	max    bool
+	seqs   []uint64
+	values []float64
+	head   int
+}
+
+// push adds value v with sequence number seq.
+func (queue *extremes) push(seq uint64, v float64) {
+	n := len(queue.values)
+	for n > queue.head && queue.dominates(v, queue.values[n-1]) {
+		n--
+	}
+	queue.seqs = append(queue.seqs[:n], seq)
+	queue.values = append(queue.values[:n], v)
+}
+
+// dominates returns whether a replaces b as the extreme.
+func (queue *extremes) dominates(a, b float64) bool {
+	if queue.max {
+		return a >= b
+	}
+	return a <= b
+}
+
+// evict removes the values added before seq.
+func (queue *extremes) evict(seq uint64) {
+	for queue.head < len(queue.seqs) && queue.seqs[queue.head] < seq {
+		queue.head++
+	}
+	if queue.head > 0 && queue.head*2 >= len(queue.seqs) {
+		n := copy(queue.seqs, queue.seqs[queue.head:])
+		copy(queue.values, queue.values[queue.head:])
+		queue.seqs, queue.values = queue.seqs[:n], queue.values[:n]
+		queue.head = 0
+	}
+}
+
+// since returns the extreme of the values added at or after seq.
+func (queue *extremes) since(seq uint64) float64 {
+	queued := queue.seqs[queue.head:]
+	i := sort.Search(len(queued), func(i int) bool { return queued[i] >= seq })
+	if i == len(queued) {
+		return math.NaN()
+	}
+	return queue.values[queue.head+i]
+}
+
+// reset removes all values.
+func (queue *extremes) reset() {
+	queue.seqs, queue.values = queue.seqs[:0], queue.values[:0]
+	queue.head = 0
+}
+
+// line returns a line using a snapshot of the values, must be called with drawMu held.
+func (stream *Stream) line() *Line {
+	stream.snapshot = stream.Snapshot(stream.snapshot[:0])
+	return &Line{
+		Style: stream.Style,
+		Label: stream.Label,
+		Data:  stream.snapshot,
+		Gaps:  stream.Gaps,
+		Step:  stream.Step,
+	}
+}
+
+// Draw draws the element to canvas.
+func (stream *Stream) Draw(plot *Plot, canvas Canvas) {
+	stream.drawMu.Lock()
+	defer stream.drawMu.Unlock()
+
+	line := stream.line()
+	if line.Style.IsZero() {
+		line.Style = *plot.autoStyle(stream, &plot.Theme.Line)
+	}
+	line.Draw(plot, canvas)
+}
+
+// HitTest finds the nearest data item to at, when the element is drawn to bounds.
+func (stream *Stream) HitTest(plot *Plot, bounds Rect, at Point) (Hit, bool) {
+	stream.drawMu.Lock()
+	defer stream.drawMu.Unlock()
+
+	hit, ok := stream.line().HitTest(plot, bounds, at)
+	hit.Element = stream
+	return hit, ok
+}
@@ -0,0 +1,80 @@
+package plot
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestStreamStats(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, window := range []float64{0, 7.5} {
+		stream := NewStream("", 16)
+		stream.Window = window
+		for i := 0; i < 200; i++ {
+			y := rng.Float64()*10 - 5
+			if rng.Intn(10) == 0 {
+				y = math.NaN()
+			}
+			stream.Add(float64(i)*0.5, y)
+			if i == 120 {
+				stream.Reset()
+			}
+
+			expected := PointsStats(stream.Snapshot(nil))
+			got := stream.Stats()
+			if !approxEqualStats(got, expected) {
+				t.Fatalf("window %v, step %d: got %v, expected %v", window, i, got, expected)
+			}
+		}
+	}
+}
+
+func TestStreamUnorderedWindow(t *testing.T) {
+	stream := NewStream("", 8)
+	stream.Window = 2
+	for _, x := range []float64{0, 1, 5, 2, 3, 4, 4.5} {
+		stream.Add(x, x)
+	}
+
+	// the window starts after 2, the newest value outside of it
+	expected := []Point{{3, 3}, {4, 4}, {4.5, 4.5}}
+	if got := stream.Snapshot(nil); !equalPoints(got, expected) {
+		t.Fatalf("got %v, expected %v", got, expected)
+	}
+	if got, expected := stream.Stats(), PointsStats(expected); !approxEqualStats(got, expected) {
+		t.Fatalf("got %v, expected %v", got, expected)
+	}
+
+	// the order is restored once the unordered values are evicted
+	for x := 5.0; x < 20; x++ {
+		stream.Add(x, x)
+	}
+	if stream.descents != 0 {
+		t.Fatalf("got %d descents, expected 0", stream.descents)
+	}
+}
+
+func TestStreamStatsPrecision(t *testing.T) {
+	stream := NewStream("", 10)
+	for i := 0; i < 1000000; i++ {
+		stream.Add(float64(i)*0.1, 1e9+float64(i%7)*0.1)
+	}
+
+	expected := PointsStats(stream.Snapshot(nil))
+	if got := stream.Stats(); !approxEqualStats(got, expected) {
+		t.Fatalf("got %v, expected %v", got, expected)
+	}
+}
+
+func approxEqualStats(a, b Stats) bool {
+	equal := func(a, b float64) bool {
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return math.IsNaN(a) && math.IsNaN(b)
+		}
+		return math.Abs(a-b) < 1e-9
+	}
+	return equal(a.Min.X, b.Min.X) && equal(a.Min.Y, b.Min.Y) &&
+		equal(a.Center.X, b.Center.X) && equal(a.Center.Y, b.Center.Y) &&
+		equal(a.Max.X, b.Max.X) && equal(a.Max.Y, b.Max.Y)
+}
@@ -8,9 +8,18 @@ type Style struct {
 	Fill   color.Color
 	Size   Length
 
+	// FillGradient is used instead of Fill, when not nil.
+	FillGradient *Gradient
+
 	// line only
-	Dash       []Length
+	Dash []Length
+	// DashOffset[0] is the distance into the dash pattern where the line starts.
 	DashOffset []Length
+	Cap        LineCap
+	Join       LineJoin
+
+	// image only
+	Sampling Sampling
 
 	// text only
 	Font     string
@@ -19,8 +28,35 @@ type Style struct {
 
 	// SVG
 	Class string
+	Title string
 }
 
+// LineCap describes the shape at the end of open lines.
+type LineCap byte
+
+const (
+	// ButtCap ends lines exactly at the end points.
+	ButtCap LineCap = iota
+	// RoundCap ends lines with a half-circle.
+	RoundCap
+	// SquareCap ends lines with a half-square extending past the end points.
+	SquareCap
+)
+
+// LineJoin describes the shape of corners between line segments.
+type LineJoin byte
+
+const (
+	// DefaultJoin uses the default join of the canvas.
+	DefaultJoin LineJoin = iota
+	// MiterJoin joins segments with a sharp corner.
+	MiterJoin
+	// RoundJoin joins segments with a circular arc.
+	RoundJoin
+	// BevelJoin joins segments by cutting off the corner.
+	BevelJoin
+)
+
 // mustExists checks whether style is valid and panics if it is not.
 func (style *Style) mustExist() {
 	if style == nil {
@@ -34,7 +70,7 @@ func (style *Style) IsZero() bool {
 		return true
 	}
 
-	return style.Stroke == nil && style.Fill == nil && style.Size == 0 && style.Font == ""
+	return style.Stroke == nil && style.Fill == nil && style.FillGradient == nil && style.Size == 0 && style.Font == ""
 }
 
 // Theme is a collection of different default styles.
@@ -44,8 +80,29 @@ type Theme struct {
 	FontSmall Style
 	Fill      Style
 	Bar       Style
+	Area      Style
+	Axis      Style
+	// Annotation is used for reference lines, spans and callouts.
+	Annotation Style
+	// Gap is used for lines connecting missing values, see GapConnect.
+	// Unset stroke and size use the line style.
+	Gap Style
+
+	// Title, Subtitle and Caption are used for the plot headings.
+	Title    Style
+	Subtitle Style
+	Caption  Style
+
+	Grid  GridTheme
+	Gizmo GizmoTheme
 
-	Grid GridTheme
+	// Background is the color behind the whole plot, nil means transparent.
+	Background color.Color
+	// Halo is the outline color around text for readability.
+	Halo color.Color
+
+	// Palette is used to assign distinct colors to unstyled elements.
+	Palette Palette
 }
 
 // GridTheme is a default style for grid.
@@ -63,8 +120,25 @@ func (theme *GridTheme) IsZero() bool {
 	return theme.Fill == nil && theme.Major == nil && theme.Minor == nil
 }
 
+// GizmoTheme is a default style for gizmo.
+type GizmoTheme struct {
+	X color.Color
+	Y color.Color
+}
+
+// IsZero checks whether theme has been defined.
+func (theme *GizmoTheme) IsZero() bool {
+	if theme == nil {
+		return true
+	}
+	return theme.X == nil && theme.Y == nil
+}
+
 // NewTheme creates a theme with default values.
-func NewTheme() Theme {
+func NewTheme() Theme { return NewLightTheme() }
+
+// NewLightTheme creates a theme with dark elements on light background.
+func NewLightTheme() Theme {
 	return Theme{
 		Line: Style{
 			Stroke: color.NRGBA{0, 0, 0, 255},
@@ -91,10 +165,248 @@ func NewTheme() Theme {
 			Fill:   color.NRGBA{0, 0, 0, 100},
 			Size:   1.0,
 		},
+		Area: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Fill:   color.NRGBA{0, 0, 0, 60},
+			Size:   1.0,
+		},
+		Axis: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Size:   1.0,
+		},
+		Annotation: Style{
+			Stroke: color.NRGBA{200, 0, 0, 255},
+			Fill:   color.NRGBA{200, 0, 0, 40},
+			Size:   1.0,
+		},
+		Gap: Style{
+			Dash: []Length{2, 2},
+		},
+		Title: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 16,
+		},
+		Subtitle: Style{
+			Fill: color.NRGBA{80, 80, 80, 255},
+			Size: 12,
+		},
+		Caption: Style{
+			Fill: color.NRGBA{100, 100, 100, 255},
+			Size: 10,
+		},
 		Grid: GridTheme{
 			Fill:  color.NRGBA{230, 230, 230, 255},
 			Major: color.NRGBA{255, 255, 255, 255},
 			Minor: color.NRGBA{255, 255, 255, 100},
 		},
+		Gizmo: GizmoTheme{
+			X: color.NRGBA{30, 0, 0, 100},
+			Y: color.NRGBA{0, 30, 0, 100},
+		},
+		Halo: color.NRGBA{255, 255, 255, 128},
+	}
+}
+
+// NewDarkTheme creates a theme with light elements on dark background.
+func NewDarkTheme() Theme {
+	return Theme{
+		Line: Style{
+			Stroke: color.NRGBA{220, 220, 220, 255},
+			Size:   1.0,
+		},
+		Font: Style{
+			Fill: color.NRGBA{220, 220, 220, 255},
+			Size: 12,
+		},
+		FontSmall: Style{
+			Fill: color.NRGBA{200, 200, 200, 255},
+			Size: 10,
+		},
+		Fill: Style{
+			Fill: color.NRGBA{30, 30, 30, 255},
+			Size: 1.0,
+		},
+		Bar: Style{
+			Stroke: color.NRGBA{220, 220, 220, 255},
+			Fill:   color.NRGBA{220, 220, 220, 100},
+			Size:   1.0,
+		},
+		Area: Style{
+			Stroke: color.NRGBA{220, 220, 220, 255},
+			Fill:   color.NRGBA{220, 220, 220, 60},
+			Size:   1.0,
+		},
+		Axis: Style{
+			Stroke: color.NRGBA{220, 220, 220, 255},
+			Size:   1.0,
+		},
+		Annotation: Style{
+			Stroke: color.NRGBA{255, 130, 110, 255},
+			Fill:   color.NRGBA{255, 130, 110, 50},
+			Size:   1.0,
+		},
+		Gap: Style{
+			Dash: []Length{2, 2},
+		},
+		Title: Style{
+			Fill: color.NRGBA{230, 230, 230, 255},
+			Size: 16,
+		},
+		Subtitle: Style{
+			Fill: color.NRGBA{180, 180, 180, 255},
+			Size: 12,
+		},
+		Caption: Style{
+			Fill: color.NRGBA{160, 160, 160, 255},
+			Size: 10,
+		},
+		Grid: GridTheme{
+			Fill:  color.NRGBA{40, 40, 40, 255},
+			Major: color.NRGBA{70, 70, 70, 255},
+			Minor: color.NRGBA{70, 70, 70, 100},
+		},
+		Gizmo: GizmoTheme{
+			X: color.NRGBA{255, 200, 200, 100},
+			Y: color.NRGBA{200, 255, 200, 100},
+		},
+		Background: color.NRGBA{30, 30, 30, 255},
+		Halo:       color.NRGBA{30, 30, 30, 128},
+		Palette:    Tableau10,
+	}
+}
+
+// NewHighContrastTheme creates a theme with thick black elements on white background.
+func NewHighContrastTheme() Theme {
+	return Theme{
+		Line: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Size:   2.0,
+		},
+		Font: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 14,
+		},
+		FontSmall: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 12,
+		},
+		Fill: Style{
+			Fill: color.NRGBA{255, 255, 255, 255},
+			Size: 1.0,
+		},
+		Bar: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Fill:   color.NRGBA{0, 0, 0, 160},
+			Size:   2.0,
+		},
+		Area: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Fill:   color.NRGBA{0, 0, 0, 120},
+			Size:   2.0,
+		},
+		Axis: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Size:   2.0,
+		},
+		Annotation: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Fill:   color.NRGBA{0, 0, 0, 60},
+			Size:   2.0,
+		},
+		Gap: Style{
+			Dash: []Length{2, 2},
+		},
+		Title: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 18,
+		},
+		Subtitle: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 14,
+		},
+		Caption: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 12,
+		},
+		Grid: GridTheme{
+			Fill:  color.NRGBA{255, 255, 255, 255},
+			Major: color.NRGBA{0, 0, 0, 120},
+			Minor: color.NRGBA{0, 0, 0, 40},
+		},
+		Gizmo: GizmoTheme{
+			X: color.NRGBA{0, 0, 0, 255},
+			Y: color.NRGBA{0, 0, 0, 255},
+		},
+		Background: color.NRGBA{255, 255, 255, 255},
+		Halo:       color.NRGBA{255, 255, 255, 255},
+		Palette:    OkabeIto,
+	}
+}
+
+// NewPrintTheme creates a grayscale theme suitable for printing.
+func NewPrintTheme() Theme {
+	return Theme{
+		Line: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Size:   1.0,
+		},
+		Font: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 12,
+		},
+		FontSmall: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 10,
+		},
+		Fill: Style{
+			Fill: color.NRGBA{255, 255, 255, 255},
+			Size: 1.0,
+		},
+		Bar: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Fill:   color.NRGBA{0, 0, 0, 80},
+			Size:   1.0,
+		},
+		Area: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Fill:   color.NRGBA{0, 0, 0, 50},
+			Size:   1.0,
+		},
+		Axis: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Size:   1.0,
+		},
+		Annotation: Style{
+			Stroke: color.NRGBA{0, 0, 0, 255},
+			Fill:   color.NRGBA{0, 0, 0, 30},
+			Size:   1.0,
+		},
+		Gap: Style{
+			Dash: []Length{2, 2},
+		},
+		Title: Style{
+			Fill: color.NRGBA{0, 0, 0, 255},
+			Size: 16,
+		},
+		Subtitle: Style{
+			Fill: color.NRGBA{60, 60, 60, 255},
+			Size: 12,
+		},
+		Caption: Style{
+			Fill: color.NRGBA{90, 90, 90, 255},
+			Size: 10,
+		},
+		Grid: GridTheme{
+			Fill:  color.NRGBA{255, 255, 255, 255},
+			Major: color.NRGBA{190, 190, 190, 255},
+			Minor: color.NRGBA{230, 230, 230, 255},
+		},
+		Gizmo: GizmoTheme{
+			X: color.NRGBA{0, 0, 0, 100},
+			Y: color.NRGBA{0, 0, 0, 100},
+		},
+		Background: color.NRGBA{255, 255, 255, 255},
+		Halo:       color.NRGBA{255, 255, 255, 128},
+		Palette:    Grayscale,
 	}
 }
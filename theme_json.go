@@ -0,0 +1,175 @@
+package plot
+
+import (
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"os"
+	"strings"
+)
+
+// LoadTheme loads a theme from a JSON file, see ParseTheme for the format.
+func LoadTheme(path string) (Theme, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Theme{}, err
+	}
+	return ParseTheme(data)
+}
+
+// ParseTheme parses a theme from JSON.
+//
+// The theme starts from a preset specified by "base" ("light", "dark",
+// "high-contrast" or "print") and the other fields override the preset.
+// Colors are specified as hex strings, e.g. "#ff0000" or "#ff000080":
+//
+//	{
+//		"base": "dark",
+//		"line": {"stroke": "#ffffff", "size": 2},
+//		"grid": {"fill": "#000000"},
+//		"palette": ["#e69f00", "#56b4e9"]
+//	}
+func ParseTheme(data []byte) (Theme, error) {
+	var file themeFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return Theme{}, fmt.Errorf("invalid theme: %w", err)
+	}
+	return file.theme()
+}
+
+// ThemePreset returns a built-in theme by name.
+func ThemePreset(name string) (Theme, bool) {
+	switch strings.ToLower(name) {
+	case "", "light":
+		return NewLightTheme(), true
+	case "dark":
+		return NewDarkTheme(), true
+	case "high-contrast":
+		return NewHighContrastTheme(), true
+	case "print", "grayscale":
+		return NewPrintTheme(), true
+	}
+	return Theme{}, false
+}
+
+// themeFile is the JSON representation of a theme.
+type themeFile struct {
+	Base string `json:"base"`
+
+	Line      *styleFile `json:"line"`
+	Font      *styleFile `json:"font"`
+	FontSmall *styleFile `json:"fontSmall"`
+	Fill      *styleFile `json:"fill"`
+	Bar       *styleFile `json:"bar"`
+	Area      *styleFile `json:"area"`
+	Axis      *styleFile `json:"axis"`
+
+	Annotation *styleFile `json:"annotation"`
+	Gap        *styleFile `json:"gap"`
+
+	Title    *styleFile `json:"title"`
+	Subtitle *styleFile `json:"subtitle"`
+	Caption  *styleFile `json:"caption"`
+
+	Grid *struct {
+		Fill  *string `json:"fill"`
+		Major *string `json:"major"`
+		Minor *string `json:"minor"`
+	} `json:"grid"`
+	Gizmo *struct {
+		X *string `json:"x"`
+		Y *string `json:"y"`
+	} `json:"gizmo"`
+
+	Background *string  `json:"background"`
+	Halo       *string  `json:"halo"`
+	Palette    []string `json:"palette"`
+}
+
+// styleFile is the JSON representation of a style.
+type styleFile struct {
+	Stroke *string   `json:"stroke"`
+	Fill   *string   `json:"fill"`
+	Size   *float64  `json:"size"`
+	Dash   []float64 `json:"dash"`
+	Font   *string   `json:"font"`
+}
+
+// theme converts the file to a theme.
+func (file *themeFile) theme() (Theme, error) {
+	theme, ok := ThemePreset(file.Base)
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown theme base %q", file.Base)
+	}
+
+	var err error
+	setColor := func(dst *color.Color, src *string) {
+		if src == nil || err != nil {
+			return
+		}
+		if *src == "" {
+			*dst = nil
+			return
+		}
+		c, ok := ParseHexColor(*src)
+		if !ok {
+			err = fmt.Errorf("invalid color %q", *src)
+			return
+		}
+		*dst = c
+	}
+	setStyle := func(dst *Style, src *styleFile) {
+		if src == nil {
+			return
+		}
+		setColor(&dst.Stroke, src.Stroke)
+		setColor(&dst.Fill, src.Fill)
+		if src.Size != nil {
+			dst.Size = *src.Size
+		}
+		if src.Dash != nil {
+			dst.Dash = src.Dash
+		}
+		if src.Font != nil {
+			dst.Font = *src.Font
+		}
+	}
+
+	setStyle(&theme.Line, file.Line)
+	setStyle(&theme.Font, file.Font)
+	setStyle(&theme.FontSmall, file.FontSmall)
+	setStyle(&theme.Fill, file.Fill)
+	setStyle(&theme.Bar, file.Bar)
+	setStyle(&theme.Area, file.Area)
+	setStyle(&theme.Axis, file.Axis)
+	setStyle(&theme.Annotation, file.Annotation)
+	setStyle(&theme.Gap, file.Gap)
+	setStyle(&theme.Title, file.Title)
+	setStyle(&theme.Subtitle, file.Subtitle)
+	setStyle(&theme.Caption, file.Caption)
+
+	if file.Grid != nil {
+		setColor(&theme.Grid.Fill, file.Grid.Fill)
+		setColor(&theme.Grid.Major, file.Grid.Major)
+		setColor(&theme.Grid.Minor, file.Grid.Minor)
+	}
+	if file.Gizmo != nil {
+		setColor(&theme.Gizmo.X, file.Gizmo.X)
+		setColor(&theme.Gizmo.Y, file.Gizmo.Y)
+	}
+
+	setColor(&theme.Background, file.Background)
+	setColor(&theme.Halo, file.Halo)
+
+	if file.Palette != nil {
+		theme.Palette = make(Palette, len(file.Palette))
+		for i := range file.Palette {
+			setColor(&theme.Palette[i], &file.Palette[i])
+		}
+	}
+
+	if err != nil {
+		return Theme{}, err
+	}
+	return theme, nil
+}
@@ -0,0 +1,79 @@
+package plot
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestThemePreset(t *testing.T) {
+	tests := []struct {
+		name       string
+		ok         bool
+		background color.Color
+	}{
+		{"", true, nil},
+		{"light", true, nil},
+		{"Dark", true, NewDarkTheme().Background},
+		{"high-contrast", true, NewHighContrastTheme().Background},
+		{"grayscale", true, NewPrintTheme().Background},
+		{"solarized", false, nil},
+	}
+
+	for _, test := range tests {
+		theme, ok := ThemePreset(test.name)
+		if ok != test.ok {
+			t.Errorf("%q: got %v, expected %v", test.name, ok, test.ok)
+			continue
+		}
+		if theme.Background != test.background {
+			t.Errorf("%q: got background %v, expected %v", test.name, theme.Background, test.background)
+		}
+	}
+}
+
+func TestParseThemeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"syntax", `{"base": "dark"`},
+		{"type", `{"line": {"size": "2"}}`},
+		{"base", `{"base": "solarized"}`},
+		{"stroke", `{"line": {"stroke": "red"}}`},
+		{"grid", `{"grid": {"major": "#12345"}}`},
+		{"background", `{"background": "#xyz"}`},
+		{"palette", `{"palette": ["#000", "blue"]}`},
+	}
+
+	for _, test := range tests {
+		if _, err := ParseTheme([]byte(test.json)); err == nil {
+			t.Errorf("%s: expected an error for %s", test.name, test.json)
+		}
+	}
+}
+
+func TestParseTheme(t *testing.T) {
+	theme, err := ParseTheme([]byte(`{
+		"base": "dark",
+		"line": {"stroke": "#ff0000", "size": 2, "dash": [3, 1]},
+		"halo": "",
+		"palette": ["#000", "#fff"]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dark := NewDarkTheme()
+	if theme.Line.Stroke != (color.NRGBA{255, 0, 0, 255}) || theme.Line.Size != 2 || len(theme.Line.Dash) != 2 {
+		t.Errorf("got line %v", theme.Line)
+	}
+	if theme.Line.Fill != dark.Line.Fill || theme.Background != dark.Background {
+		t.Errorf("unspecified fields don't use the base")
+	}
+	if theme.Halo != nil {
+		t.Errorf("got halo %v, expected nil", theme.Halo)
+	}
+	if len(theme.Palette) != 2 || theme.Palette[1] != (color.NRGBA{255, 255, 255, 255}) {
+		t.Errorf("got palette %v", theme.Palette)
+	}
+}
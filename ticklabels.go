@@ -1,5 +1,7 @@
 package plot
 
+import "math"
+
 // TickLabels implements drawing tick labels.
 type TickLabels struct {
 	X *TickLabelsX
@@ -20,12 +22,26 @@ func (labels *TickLabels) Draw(plot *Plot, canvas Canvas) {
 	labels.Y.Draw(plot, canvas)
 }
 
+// Overflow calculates how much the labels extend beyond bounds on each side.
+func (labels *TickLabels) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	return maxInsets(
+		labels.X.Overflow(plot, measurer, bounds),
+		labels.Y.Overflow(plot, measurer, bounds),
+	)
+}
+
 // TickLabelsX implements drawing tick labels.
 type TickLabelsX struct {
 	Enabled bool
 	// Side determines position of the labels. -1 = min, 0 = center, 1 = max
 	Side  float64
 	Style Style
+
+	// Placement determines how overlapping labels are resolved,
+	// by default all labels are drawn.
+	Placement LabelPlacement
+	// Spacing is the minimum distance between labels.
+	Spacing Length
 }
 
 // NewTickLabelsX creates a new tick labelling element for X axis.
@@ -36,37 +52,134 @@ func NewTickLabelsX() *TickLabelsX {
 	return labels
 }
 
-// Draw draws tick labels to canvas using axes from plot.
-func (labels *TickLabelsX) Draw(plot *Plot, canvas Canvas) {
-	if !labels.Enabled {
-		return
+// style returns the style used for drawing labels.
+func (labels *TickLabelsX) style(plot *Plot) *Style {
+	if labels.Style.IsZero() {
+		return &plot.Theme.FontSmall
 	}
+	return &labels.Style
+}
 
+// place calculates the labels to draw and their style.
+func (labels *TickLabelsX) place(plot *Plot, measurer TextMeasurer, size Point) ([]tickLabel, *Style) {
 	x, y := plot.X, plot.Y
+	style := *labels.style(plot)
 
-	sz := canvas.Bounds().Size()
 	yval := lerpUnit(labels.Side, y.Min, y.Max)
-	ypos := y.ToCanvas(yval, 0, sz.Y)
+	ypos := y.ToCanvas(yval, 0, size.Y)
 
-	style := &labels.Style
-	if style.IsZero() {
-		style = &plot.Theme.FontSmall
+	var placed []tickLabel
+	for _, tick := range x.Ticks.Ticks(x) {
+		if tick.Label == "" {
+			continue
+		}
+		p := x.ToCanvas(tick.Value, 0, size.X)
+		placed = append(placed, tickLabel{
+			text:     tick.Label,
+			at:       P(p, ypos),
+			pos:      p,
+			priority: tickPriority(tick.Value),
+		})
 	}
+	sortLabels(placed)
 
-	for _, tick := range x.Ticks.Ticks(x) {
-		p := x.ToCanvas(tick.Value, 0, sz.X)
-		if tick.Label != "" {
-			canvas.Text(tick.Label, P(p, ypos), style)
+	spacing := labels.Spacing
+	if spacing <= 0 {
+		spacing = defaultLabelSpacing
+	}
+
+	height := 0.0
+	measure := func() {
+		unrotated := style
+		unrotated.Rotation = 0
+		for i := range placed {
+			label := &placed[i]
+			r := measurer.MeasureText(label.text, &unrotated)
+			height = math.Max(height, r.Size().Y)
+			if style.Rotation == 0 {
+				label.min, label.max = label.pos+r.Min.X, label.pos+r.Max.X
+			} else {
+				// rotated labels are parallel strips, which overlap depending on the text height
+				half := r.Size().Y * 0.5 / math.Abs(math.Sin(style.Rotation))
+				label.min, label.max = label.pos-half, label.pos+half
+			}
+		}
+	}
+
+	switch labels.Placement {
+	case LabelsDrop:
+		measure()
+		placed = dropLabels(placed, 1, spacing)
+	case LabelsRotate:
+		measure()
+		if fitLabels(placed, 1, spacing) {
+			break
+		}
+		// the text ends at the tick and extends away from the plot
+		style.Origin = Point{1, 0}
+		if labels.Side > 0 {
+			style.Origin.X = -1
 		}
+		for _, angle := range []float64{math.Pi / 4, math.Pi / 2} {
+			style.Rotation = -angle
+			measure()
+			if fitLabels(placed, 1, spacing) {
+				break
+			}
+		}
+		placed = dropLabels(placed, 1, spacing)
+	case LabelsStagger:
+		measure()
+		if fitLabels(placed, 1, spacing) {
+			break
+		}
+		placed = dropLabels(placed, 2, spacing)
+		offset := height + spacing*0.5
+		if labels.Side > 0 {
+			offset = -offset
+		}
+		for i := 1; i < len(placed); i += 2 {
+			placed[i].at.Y += offset
+		}
+	}
+
+	return placed, &style
+}
+
+// Draw draws tick labels to canvas using axes from plot.
+func (labels *TickLabelsX) Draw(plot *Plot, canvas Canvas) {
+	if !labels.Enabled {
+		return
+	}
+
+	placed, style := labels.place(plot, textMeasurer(canvas), canvas.Bounds().Size())
+	for _, label := range placed {
+		canvas.Text(label.text, label.at, style)
 	}
 }
 
+// Overflow calculates how much the labels extend beyond bounds on each side.
+func (labels *TickLabelsX) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	if !labels.Enabled {
+		return Rect{}
+	}
+
+	placed, style := labels.place(plot, measurer, bounds.Size())
+	return tickLabelsOverflow(placed, style, measurer, bounds)
+}
+
 // TickLabelsY implements drawing tick labels.
 type TickLabelsY struct {
 	Enabled bool
 	// Side determines position of the labels. -1 = min, 0 = center, 1 = max
 	Side  float64
 	Style Style
+
+	// Placement determines how overlapping labels are resolved,
+	// by default all labels are drawn.
+	Placement LabelPlacement
+	// Spacing is the minimum distance between labels.
+	Spacing Length
 }
 
 // NewTickLabelsY creates a new tick labelling element for X axis.
@@ -77,27 +190,97 @@ func NewTickLabelsY() *TickLabelsY {
 	return labels
 }
 
+// style returns the style used for drawing labels.
+func (labels *TickLabelsY) style(plot *Plot) *Style {
+	if labels.Style.IsZero() {
+		return &plot.Theme.FontSmall
+	}
+	return &labels.Style
+}
+
+// place calculates the labels to draw and their style.
+func (labels *TickLabelsY) place(plot *Plot, measurer TextMeasurer, size Point) ([]tickLabel, *Style) {
+	x, y := plot.X, plot.Y
+	style := labels.style(plot)
+
+	xval := lerpUnit(labels.Side, x.Min, x.Max)
+	xpos := x.ToCanvas(xval, 0, size.X)
+
+	var placed []tickLabel
+	for _, tick := range y.Ticks.Ticks(y) {
+		if tick.Label == "" {
+			continue
+		}
+		p := y.ToCanvas(tick.Value, 0, size.Y)
+		placed = append(placed, tickLabel{
+			text:     tick.Label,
+			at:       P(xpos, p),
+			pos:      p,
+			priority: tickPriority(tick.Value),
+		})
+	}
+	sortLabels(placed)
+
+	if labels.Placement == LabelsOverlap {
+		return placed, style
+	}
+
+	spacing := labels.Spacing
+	if spacing <= 0 {
+		spacing = defaultLabelSpacing
+	}
+
+	width := 0.0
+	for i := range placed {
+		label := &placed[i]
+		r := measurer.MeasureText(label.text, style)
+		width = math.Max(width, r.Size().X)
+		label.min, label.max = label.pos+r.Min.Y, label.pos+r.Max.Y
+	}
+
+	if labels.Placement != LabelsStagger || fitLabels(placed, 1, spacing) {
+		return dropLabels(placed, 1, spacing), style
+	}
+
+	placed = dropLabels(placed, 2, spacing)
+	offset := -(width + spacing)
+	if labels.Side > 0 {
+		offset = -offset
+	}
+	for i := 1; i < len(placed); i += 2 {
+		placed[i].at.X += offset
+	}
+	return placed, style
+}
+
 // Draw draws tick labels to canvas using axes from plot.
 func (labels *TickLabelsY) Draw(plot *Plot, canvas Canvas) {
 	if !labels.Enabled {
 		return
 	}
 
-	x, y := plot.X, plot.Y
-
-	sz := canvas.Bounds().Size()
-	xval := lerpUnit(labels.Side, x.Min, x.Max)
-	xpos := x.ToCanvas(xval, 0, sz.X)
+	placed, style := labels.place(plot, textMeasurer(canvas), canvas.Bounds().Size())
+	for _, label := range placed {
+		canvas.Text(label.text, label.at, style)
+	}
+}
 
-	style := &labels.Style
-	if style.IsZero() {
-		style = &plot.Theme.FontSmall
+// Overflow calculates how much the labels extend beyond bounds on each side.
+func (labels *TickLabelsY) Overflow(plot *Plot, measurer TextMeasurer, bounds Rect) Rect {
+	if !labels.Enabled {
+		return Rect{}
 	}
 
-	for _, tick := range y.Ticks.Ticks(y) {
-		p := y.ToCanvas(tick.Value, 0, sz.Y)
-		if tick.Label != "" {
-			canvas.Text(tick.Label, P(xpos, p), style)
-		}
+	placed, style := labels.place(plot, measurer, bounds.Size())
+	return tickLabelsOverflow(placed, style, measurer, bounds)
+}
+
+// tickLabelsOverflow calculates how much the placed labels extend beyond bounds.
+func tickLabelsOverflow(placed []tickLabel, style *Style, measurer TextMeasurer, bounds Rect) Rect {
+	var overflow Rect
+	for _, label := range placed {
+		r := measurer.MeasureText(label.text, style).Offset(label.at.Add(bounds.Min))
+		overflow = maxInsets(overflow, overflowOf(bounds, r))
 	}
+	return overflow
 }
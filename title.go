@@ -0,0 +1,113 @@
+package plot
+
+import "strings"
+
+// titleLine is a single line of a heading.
+type titleLine struct {
+	text  string
+	style Style
+}
+
+// titleLines returns the non-empty lines with their styles centered horizontally.
+func titleLines(lines ...titleLine) []titleLine {
+	result := lines[:0]
+	for _, line := range lines {
+		if line.text == "" {
+			continue
+		}
+		line.style.Origin = Point{0, -1}
+		line.style.Rotation = 0
+		result = append(result, line)
+	}
+	return result
+}
+
+// titleHeight calculates the height of lines including spacing after each line.
+func titleHeight(measurer TextMeasurer, lines []titleLine) Length {
+	height := 0.0
+	for i := range lines {
+		height += measurer.MeasureText(lines[i].text, &lines[i].style).Size().Y + defaultLabelSpacing
+	}
+	return height
+}
+
+// drawTitle draws lines centered at x, starting from top.
+func drawTitle(canvas Canvas, measurer TextMeasurer, lines []titleLine, x, top Length) {
+	at := Point{x, top}
+	for i := range lines {
+		line := &lines[i]
+		canvas.Text(line.text, at, &line.style)
+		at.Y += measurer.MeasureText(line.text, &line.style).Size().Y + defaultLabelSpacing
+	}
+}
+
+// header returns the title and subtitle lines of the plot.
+func (plot *Plot) header() []titleLine {
+	return titleLines(
+		titleLine{plot.Title, plot.Theme.Title},
+		titleLine{plot.Subtitle, plot.Theme.Subtitle},
+	)
+}
+
+// footer returns the caption lines of the plot.
+func (plot *Plot) footer() []titleLine {
+	return titleLines(
+		titleLine{plot.Caption, plot.Theme.Caption},
+	)
+}
+
+// titleInsets calculates the space needed for the headings above and below the plot area.
+func (plot *Plot) titleInsets(measurer TextMeasurer) Rect {
+	var insets Rect
+	insets.Min.Y = titleHeight(measurer, plot.header())
+	insets.Max.Y = titleHeight(measurer, plot.footer())
+	return insets
+}
+
+// area returns the plot area inside bounds. When the margin hasn't been
+// set, e.g. by Layout, only the space for the headings is reserved.
+func (plot *Plot) area(measurer TextMeasurer, bounds Rect) Rect {
+	if !plot.Margin.Empty() {
+		return bounds.Inset(plot.Margin)
+	}
+
+	insets := plot.titleInsets(measurer)
+	if insets.Empty() {
+		return bounds
+	}
+	if insets.Min.Y > 0 {
+		insets.Min.Y += plot.Padding.Min.Y
+	}
+	if insets.Max.Y > 0 {
+		insets.Max.Y += plot.Padding.Max.Y
+	}
+	return bounds.Inset(insets)
+}
+
+// drawTitles draws the title, subtitle and caption, where area is the plot area.
+func (plot *Plot) drawTitles(canvas Canvas, area Rect) {
+	header, footer := plot.header(), plot.footer()
+	if len(header) == 0 && len(footer) == 0 {
+		return
+	}
+
+	measurer := textMeasurer(canvas)
+	bounds := canvas.Bounds()
+	center := (area.Min.X + area.Max.X) * 0.5
+
+	drawTitle(canvas, measurer, header, center, bounds.Min.Y+plot.Padding.Min.Y)
+
+	bottom := bounds.Max.Y - plot.Padding.Max.Y - titleHeight(measurer, footer)
+	drawTitle(canvas, measurer, footer, center, bottom)
+}
+
+// description returns the subtitle and caption as a single text.
+func (plot *Plot) description() string {
+	var lines []string
+	for _, line := range []string{plot.Subtitle, plot.Caption} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
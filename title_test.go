@@ -0,0 +1,55 @@
+package plot
+
+import "testing"
+
+func TestAreaWithoutLayout(t *testing.T) {
+	bounds := R(0, 0, 400, 300)
+
+	p := New()
+	if got := p.area(ApproxTextMeasurer{}, bounds); got != bounds {
+		t.Errorf("without titles: got %v", got)
+	}
+
+	p.Title = "Title"
+	p.Caption = "Caption"
+	measurer := ApproxTextMeasurer{}
+	got := p.area(measurer, bounds)
+	if top := titleHeight(measurer, p.header()); got.Min.Y < top {
+		t.Errorf("title overlaps the plot area: got %v, title height %v", got, top)
+	}
+	if bottom := titleHeight(measurer, p.footer()); got.Max.Y > bounds.Max.Y-bottom {
+		t.Errorf("caption overlaps the plot area: got %v, caption height %v", got, bottom)
+	}
+}
+
+// measuredCanvas is a canvas with a fixed text height.
+type measuredCanvas struct {
+	polyCanvas
+	height Length
+}
+
+func (canvas *measuredCanvas) MeasureText(text string, style *Style) Rect {
+	return R(0, 0, 10, canvas.height)
+}
+
+func TestHitTestUsesDrawArea(t *testing.T) {
+	p := New()
+	p.Title = "Title"
+	p.X.Min, p.X.Max = 0, 1
+	p.Y.Min, p.Y.Max = 0, 1
+	p.Add(NewLine("", []Point{{0, 1}, {1, 1}}))
+
+	canvas := &measuredCanvas{polyCanvas: polyCanvas{bounds: R(0, 0, 100, 200)}, height: 50}
+	area := p.Area(canvas)
+	if expected := Length(50 + defaultLabelSpacing); area.Min.Y != expected {
+		t.Fatalf("got area %v, expected top at %v", area, expected)
+	}
+
+	hit, ok := p.HitTest(canvas, Point{50, 60})
+	if !ok {
+		t.Fatal("no hit")
+	}
+	if hit.Position.Y != area.Min.Y {
+		t.Errorf("got hit at %v, expected the top of the area %v", hit.Position, area)
+	}
+}
@@ -0,0 +1,87 @@
+package plot
+
+import (
+	"image/color"
+	"math"
+	"strings"
+)
+
+// Tooltips implements hover targets for the nearest data items of elements.
+//
+// The plot area is split into columns and each column gets a transparent
+// rectangle with a title describing the nearest data item of each element. Backends that
+// support Style.Title, such as plotsvg, show it when hovering.
+type Tooltips struct {
+	// Spacing is the width of a single hover column.
+	Spacing Length
+	Elements
+}
+
+// NewTooltips creates hover targets for the specified elements.
+func NewTooltips(els ...Element) *Tooltips {
+	return &Tooltips{
+		Spacing:  4,
+		Elements: Elements(els),
+	}
+}
+
+// Draw draws the elements and the hover targets.
+func (tooltips *Tooltips) Draw(plot *Plot, canvas Canvas) {
+	tooltips.Elements.Draw(plot, canvas)
+
+	spacing := tooltips.Spacing
+	if spacing <= 0 {
+		spacing = 4
+	}
+
+	bounds := canvas.Bounds()
+	size := bounds.Size()
+
+	var last []Hit
+	start := 0.0
+	flush := func(end Length) {
+		if len(last) == 0 {
+			return
+		}
+		var lines []string
+		for _, hit := range last {
+			lines = append(lines, hit.Text()...)
+		}
+		canvas.Rect(R(start, 0, end, size.Y), &Style{
+			Fill:  color.Transparent,
+			Class: "tooltip",
+			Title: strings.Join(lines, "\n"),
+		})
+	}
+
+	for x := 0.0; x < size.X; x += spacing {
+		at := P(x+spacing*0.5, math.NaN())
+
+		var hits []Hit
+		for _, el := range tooltips.Elements {
+			if hit, ok := hitTestElements(plot, []Element{el}, bounds, at); ok {
+				hits = append(hits, hit)
+			}
+		}
+		if sameHits(hits, last) {
+			continue
+		}
+
+		flush(x)
+		last, start = hits, x
+	}
+	flush(size.X)
+}
+
+// sameHits checks whether hits refer to the same data items.
+func sameHits(a, b []Hit) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Element != b[i].Element || a[i].Index != b[i].Index {
+			return false
+		}
+	}
+	return true
+}
@@ -66,7 +66,7 @@ func (line *Violin) Draw(plot *Plot, canvas Canvas) {
 	invkernel := 1 / kernel
 
 	points := []Point{}
-	if line.Fill != nil || line.Side == 0 {
+	if line.HasFill() || line.Side == 0 {
 		points = append(points, Point{0, ymin})
 	}
 
@@ -103,7 +103,7 @@ func (line *Violin) Draw(plot *Plot, canvas Canvas) {
 		})
 	}
 
-	if line.Fill != nil || line.Side == 0 {
+	if line.HasFill() || line.Side == 0 {
 		points = append(points, Point{0, ymax})
 	}
 
@@ -128,9 +128,9 @@ func (line *Violin) Draw(plot *Plot, canvas Canvas) {
 		}
 	}
 
-	if !line.Style.IsZero() {
-		canvas.Poly(points, &line.Style)
-	} else {
-		canvas.Poly(points, &plot.Theme.Line)
+	style := &line.Style
+	if style.IsZero() {
+		style = plot.autoStyle(line, &plot.Theme.Line)
 	}
+	canvas.Poly(points, plot.resolveStyle(style, canvas.Bounds()))
 }